@@ -0,0 +1,5 @@
+module github.com/ReiRoseGit/sgu-graph
+
+go 1.17
+
+require github.com/cheekybits/genny v1.0.0
@@ -0,0 +1,138 @@
+package graph
+
+import (
+	"context"
+	"sort"
+)
+
+/*
+
+Клики:
+- TriangleCount - количество треугольников (клик из трех вершин)
+- MaximalCliques, MaximalCliquesCtx - все максимальные по включению клики (алгоритм Брона-Кербоша)
+
+*/
+
+// TriangleCount - возвращает количество треугольников графа. Для каждого ребра u - v (u < v)
+// пересекаются множества соседей концов: перебираются соседи конца меньшей степени и проверяется
+// их смежность с другим концом, учитываются только соседи w > v, поэтому каждый треугольник u < v < w
+// считается один раз. Направление дуг не учитывается, параллельные связи считаются одной, петли пропускаются
+func (g *Graph) TriangleCount() int {
+	neighbors := g.linkCounts()
+	count := 0
+	for u, adjacent := range neighbors {
+		for v := range adjacent {
+			if v.toString() <= u.toString() {
+				continue
+			}
+			small, large := u, v
+			if len(neighbors[v]) < len(neighbors[u]) {
+				small, large = v, u
+			}
+			for w := range neighbors[small] {
+				if w.toString() <= v.toString() {
+					continue
+				}
+				if _, ok := neighbors[large][w]; ok {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+// MaximalCliques - возвращает все максимальные по включению клики графа (см. MaximalCliquesCtx).
+// Время работы в худшем случае экспоненциально, поэтому метод предназначен для небольших графов
+func (g *Graph) MaximalCliques() [][]string {
+	cliques, _ := g.MaximalCliquesCtx(context.Background())
+	return cliques
+}
+
+// MaximalCliquesCtx - находит все максимальные по включению клики алгоритмом Брона-Кербоша с выбором опорной вершины:
+// ветвление идет только по кандидатам, не смежным с опорной вершиной, у которой больше всего соседей среди кандидатов.
+// Направление дуг не учитывается, петли пропускаются, изолированная вершина - клика из одной вершины,
+// у пустого графа клик нет.
+// Каждая клика отсортирована по значениям, клики упорядочены лексикографически.
+// Контекст проверяется при каждом рекурсивном вызове, при отмене или истечении срока возвращается ctx.Err()
+func (g *Graph) MaximalCliquesCtx(ctx context.Context) ([][]string, error) {
+	neighbors := g.linkCounts()
+	result := [][]string{}
+
+	// clique - текущая клика, candidates - вершины, которыми ее можно расширить,
+	// excluded - вершины, все клики с которыми уже найдены
+	var search func(clique []string, candidates, excluded map[*Node]bool) error
+	search = func(clique []string, candidates, excluded map[*Node]bool) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			if len(excluded) == 0 {
+				found := append([]string{}, clique...)
+				sort.Strings(found)
+				result = append(result, found)
+			}
+			return nil
+		}
+		var pivot *Node
+		best := -1
+		for _, set := range []map[*Node]bool{candidates, excluded} {
+			for u := range set {
+				count := 0
+				for v := range neighbors[u] {
+					if candidates[v] {
+						count++
+					}
+				}
+				if count > best {
+					pivot, best = u, count
+				}
+			}
+		}
+		branches := []*Node{}
+		for v := range candidates {
+			if _, ok := neighbors[pivot][v]; !ok {
+				branches = append(branches, v)
+			}
+		}
+		for _, v := range branches {
+			nextCandidates := make(map[*Node]bool)
+			nextExcluded := make(map[*Node]bool)
+			for u := range neighbors[v] {
+				if candidates[u] {
+					nextCandidates[u] = true
+				}
+				if excluded[u] {
+					nextExcluded[u] = true
+				}
+			}
+			if err := search(append(clique, v.toString()), nextCandidates, nextExcluded); err != nil {
+				return err
+			}
+			delete(candidates, v)
+			excluded[v] = true
+		}
+		return nil
+	}
+
+	if len(g.edges) == 0 {
+		return result, nil
+	}
+	candidates := make(map[*Node]bool, len(g.edges))
+	for node := range g.edges {
+		candidates[node] = true
+	}
+	if err := search([]string{}, candidates, map[*Node]bool{}); err != nil {
+		return nil, err
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return result, nil
+}
@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTriangleCount(t *testing.T) {
+	multigraph := NewEmptyMultigraph()
+	for _, e := range [][2]string{{"a", "b"}, {"a", "b"}, {"b", "c"}, {"c", "a"}, {"b", "a"}} {
+		multigraph.AddEdge(e[0], e[1], 1)
+	}
+	tests := []struct {
+		name string
+		g    *Graph
+		want int
+	}{
+		{"K_4", NewCompleteGraph([]string{"a", "b", "c", "d"}), 4},
+		{"K_5", NewCompleteGraph([]string{"a", "b", "c", "d", "e"}), 10},
+		{"цикл на 5 вершинах", NewCycleGraph(5), 0},
+		{"решетка", NewGridGraph(3, 3), 0},
+		{"два треугольника с общей вершиной", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"d", "e"}, {"e", "c"}}), 2},
+		{"направление дуг не учитывается", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"a", "c"}}), 1},
+		{"петли пропускаются", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"a", "a"}}), 1},
+		{"параллельные связи - одна", multigraph, 1},
+		{"пустой граф", NewEmptyGraph(), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.TriangleCount(); got != tt.want {
+				t.Errorf("TriangleCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaximalCliques(t *testing.T) {
+	blocks := mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"d", "e"}, {"e", "c"}, {"e", "f"}})
+	blocks.AddNode("z")
+	tests := []struct {
+		name string
+		g    *Graph
+		want [][]string
+	}{
+		{"треугольники, мост и изолированная вершина", blocks, [][]string{{"a", "b", "c"}, {"c", "d", "e"}, {"e", "f"}, {"z"}}},
+		{"K_4", NewCompleteGraph([]string{"d", "c", "b", "a"}), [][]string{{"a", "b", "c", "d"}}},
+		{"путь", NewPathGraph(3), [][]string{{"0", "1"}, {"1", "2"}}},
+		{"K_4 без ребра", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}}), [][]string{{"a", "b", "c"}, {"a", "b", "d"}}},
+		{"направление дуг не учитывается", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}}), [][]string{{"a", "b", "c"}}},
+		{"петли пропускаются", mustGraph(t, false, false, [][3]string{{"a", "a"}, {"a", "b"}}), [][]string{{"a", "b"}}},
+		{"пустой граф", NewEmptyGraph(), [][]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.MaximalCliques(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MaximalCliques() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaximalCliquesRandom(t *testing.T) {
+	// Каждая найденная клика - клика, максимальная по включению, и клики не повторяются
+	g := NewRandomGraph(14, 0.5, false, false, rand.New(rand.NewSource(5)))
+	adjacent := func(u, v string) bool {
+		_, ok := g.edgeWeight(u, v)
+		return ok
+	}
+	cliques, err := g.MaximalCliquesCtx(context.Background())
+	if err != nil {
+		t.Fatalf("MaximalCliquesCtx() error = %v", err)
+	}
+	seen := map[string]bool{}
+	for _, clique := range cliques {
+		key := strings.Join(clique, " ")
+		if seen[key] {
+			t.Errorf("клика %v найдена дважды", clique)
+		}
+		seen[key] = true
+		members := map[string]bool{}
+		for i, u := range clique {
+			members[u] = true
+			for _, v := range clique[i+1:] {
+				if !adjacent(u, v) {
+					t.Errorf("%v не клика: нет ребра %s - %s", clique, u, v)
+				}
+			}
+		}
+		for _, w := range g.nodeValues() {
+			if members[w] {
+				continue
+			}
+			extends := true
+			for _, u := range clique {
+				extends = extends && adjacent(u, w)
+			}
+			if extends {
+				t.Errorf("клика %v не максимальна: ее расширяет %s", clique, w)
+			}
+		}
+	}
+}
+
+func TestMaximalCliquesCtxCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cliques, err := NewCompleteGraph([]string{"a", "b", "c"}).MaximalCliquesCtx(ctx)
+	if !errors.Is(err, context.Canceled) || cliques != nil {
+		t.Errorf("MaximalCliquesCtx() = %v, %v, want <nil>, %v", cliques, err, context.Canceled)
+	}
+}
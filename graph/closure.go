@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+)
+
+/*
+
+Замыкания орграфа:
+- MaxWeightClosure - замыкание максимального веса
+
+*/
+
+// uniqueNodeValue - возвращает значение, которого нет среди узлов графа, начиная с base
+func (g *Graph) uniqueNodeValue(base string) string {
+	value := base
+	for g.getRefOfNode(value) != nil {
+		value += "_"
+	}
+	return value
+}
+
+// MaxWeightClosure - находит замыкание максимального веса: подмножество вершин, в которое вместе
+// с каждой вершиной входят все концы ее исходящих дуг, с максимальной суммой весов weights.
+// Вершины, отсутствующие в weights, имеют вес 0. Задача сводится к минимальному разрезу:
+// из истока идут дуги в вершины с положительным весом, из вершин с отрицательным весом - в сток,
+// исходные дуги получают бесконечную пропускную способность.
+// Возвращает отсортированный список вершин замыкания и его вес
+func (g *Graph) MaxWeightClosure(weights map[string]int) ([]string, int, error) {
+	if !g.is_oriented {
+		return nil, 0, errors.New("Граф должен быть ориентированным")
+	}
+	for value := range weights {
+		if err := validateNode(g, value); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// Бесконечная пропускная способность - больше суммы всех положительных весов
+	positive := 0
+	for _, w := range weights {
+		if w > 0 {
+			positive += w
+		}
+	}
+	infinity := positive + 1
+
+	network := NewEmptyGraph()
+	source := g.uniqueNodeValue("source")
+	sink := g.uniqueNodeValue("sink")
+	network.addNode(source)
+	network.addNode(sink)
+	for node, v := range g.edges {
+		network.addNode(node.toString())
+		for next := range v {
+			network.AddEdge(node.toString(), next.toString(), infinity)
+		}
+		if w := weights[node.toString()]; w > 0 {
+			network.AddEdge(source, node.toString(), w)
+		} else if w < 0 {
+			network.AddEdge(node.toString(), sink, -w)
+		}
+	}
+
+	s := network.getRefOfNode(source)
+	flow, C, F := network.fordFulkerson(s, network.getRefOfNode(sink))
+
+	// Замыкание - вершины, достижимые из истока в остаточной сети
+	visited := map[*Node]bool{s: true}
+	queue := []*Node{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for v := range network.edges {
+			if !visited[v] && (*C)[u][v]-(*F)[u][v] > 0 {
+				visited[v] = true
+				queue = append(queue, v)
+			}
+		}
+	}
+	result := []string{}
+	for node := range visited {
+		if node != s {
+			result = append(result, node.toString())
+		}
+	}
+	sort.Strings(result)
+	return result, positive - flow, nil
+}
@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxWeightClosure(t *testing.T) {
+	precedence := [][3]string{{"a", "x"}, {"a", "y"}, {"b", "y"}, {"b", "z"}}
+	tests := []struct {
+		name       string
+		g          *Graph
+		weights    map[string]int
+		want       []string
+		wantWeight int
+		wantErr    bool
+	}{
+		{"выгоден только первый проект", mustGraph(t, true, false, precedence),
+			map[string]int{"a": 10, "b": 5, "x": -4, "y": -3, "z": -8}, []string{"a", "x", "y"}, 3, false},
+		{"выгодны оба проекта", mustGraph(t, true, false, precedence),
+			map[string]int{"a": 10, "b": 9, "x": -4, "y": -3, "z": -8}, []string{"a", "b", "x", "y", "z"}, 4, false},
+		{"все веса отрицательные", mustGraph(t, true, false, precedence),
+			map[string]int{"x": -1}, []string{}, 0, false},
+		{"замыкание по цепочке", mustGraph(t, true, false, [][3]string{{"source", "sink"}, {"sink", "c"}}),
+			map[string]int{"source": 5, "sink": -1, "c": -2}, []string{"c", "sink", "source"}, 2, false},
+		{"неориентированный граф", mustGraph(t, false, false, precedence), nil, nil, 0, true},
+		{"неизвестная вершина", mustGraph(t, true, false, precedence), map[string]int{"q": 1}, nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, weight, err := tt.g.MaxWeightClosure(tt.weights)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MaxWeightClosure() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) || weight != tt.wantWeight {
+				t.Errorf("MaxWeightClosure() = %v, %d, want %v, %d", got, weight, tt.want, tt.wantWeight)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package graph
+
+import "sort"
+
+/*
+
+Раскраска графа:
+- GreedyColoring - жадная раскраска вершин
+
+*/
+
+// GreedyColoring - возвращает правильную раскраску вершин (смежные вершины получают разные цвета, цвета
+// нумеруются с 0) и количество использованных цветов. Вершины обрабатываются по убыванию степени,
+// при равенстве - по значению, и получают наименьший цвет, не занятый соседями. Раскраска не обязательно
+// минимальна. Для орграфа направление дуг не учитывается, петли игнорируются
+func (g *Graph) GreedyColoring() (map[string]int, int) {
+	// Соседи без учета направления
+	neighbors := make(map[*Node]map[*Node]bool, len(g.edges))
+	for node := range g.edges {
+		neighbors[node] = make(map[*Node]bool)
+	}
+	for node, v := range g.edges {
+		for next := range v {
+			if next != node {
+				neighbors[node][next] = true
+				neighbors[next][node] = true
+			}
+		}
+	}
+
+	order := make([]*Node, 0, len(g.edges))
+	for node := range g.edges {
+		order = append(order, node)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		if len(neighbors[order[i]]) != len(neighbors[order[j]]) {
+			return len(neighbors[order[i]]) > len(neighbors[order[j]])
+		}
+		return order[i].toString() < order[j].toString()
+	})
+
+	colors := make(map[*Node]int, len(order))
+	count := 0
+	for _, node := range order {
+		used := make(map[int]bool)
+		for next := range neighbors[node] {
+			if c, ok := colors[next]; ok {
+				used[c] = true
+			}
+		}
+		color := 0
+		for used[color] {
+			color++
+		}
+		colors[node] = color
+		if color+1 > count {
+			count = color + 1
+		}
+	}
+
+	result := make(map[string]int, len(colors))
+	for node, c := range colors {
+		result[node.toString()] = c
+	}
+	return result, count
+}
@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGreedyColoring(t *testing.T) {
+	tests := []struct {
+		name      string
+		g         *Graph
+		wantCount int
+	}{
+		{"полный граф K4", NewCompleteGraph([]string{"a", "b", "c", "d"}), 4},
+		{"четный цикл", NewCycleGraph(6), 2},
+		{"нечетный цикл", NewCycleGraph(5), 3},
+		{"звезда", starGraph(t, "a", "b", "d"), 2},
+		{"решетка", NewGridGraph(3, 4), 2},
+		{"петля не мешает", mustGraph(t, false, false, [][3]string{{"a", "a"}, {"a", "b"}}), 2},
+		{"орграф без учета направления", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}}), 3},
+		{"случайный граф", NewRandomGraph(30, 0.3, false, false, rand.New(rand.NewSource(7))), -1},
+		{"пустой граф", NewEmptyGraph(), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			colors, count := tt.g.GreedyColoring()
+			if tt.wantCount >= 0 && count != tt.wantCount {
+				t.Errorf("GreedyColoring() использует %d цветов, want %d", count, tt.wantCount)
+			}
+			if len(colors) != len(tt.g.edges) {
+				t.Errorf("раскрашено %d вершин из %d", len(colors), len(tt.g.edges))
+			}
+			// Раскраска правильная: концы каждой связи, кроме петель, окрашены по-разному
+			for _, e := range tt.g.Edges() {
+				if e.From != e.To && colors[e.From] == colors[e.To] {
+					t.Errorf("%s и %s окрашены в цвет %d", e.From, e.To, colors[e.From])
+				}
+			}
+			for value, c := range colors {
+				if c < 0 || c >= count {
+					t.Errorf("цвет %s = %d вне диапазона [0, %d)", value, c, count)
+				}
+			}
+		})
+	}
+}
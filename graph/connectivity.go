@@ -0,0 +1,418 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+)
+
+/*
+
+Связность и отказоустойчивость:
+- IsConnected - связность графа
+- IsStronglyConnected, IsWeaklyConnected - сильная и слабая связность орграфа
+- IndependentSpanningTrees - пара независимых остовных деревьев
+- Bridges - мосты графа
+- TwoEdgeConnectedComponents - компоненты реберной двусвязности
+- BiconnectedComponents - блоки (компоненты вершинной двусвязности)
+
+*/
+
+// sortedNeighbors - возвращает соседей вершины (без петли), отсортированных по значению
+func (g *Graph) sortedNeighbors(node *Node) []*Node {
+	result := make([]*Node, 0, len(g.edges[node]))
+	for next := range g.edges[node] {
+		if next != node {
+			result = append(result, next)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].toString() < result[j].toString()
+	})
+	return result
+}
+
+// anyNode - возвращает произвольную вершину графа или nil для пустого графа
+func (g *Graph) anyNode() *Node {
+	for node := range g.edges {
+		return node
+	}
+	return nil
+}
+
+// IsConnected - проверяет связность графа: обход в ширину из любой вершины посещает все вершины.
+// Для орграфа проверяется слабая связность. Пустой граф считается связным
+func (g *Graph) IsConnected() bool {
+	if g.is_oriented {
+		return g.IsWeaklyConnected()
+	}
+	start := g.anyNode()
+	if start == nil {
+		return true
+	}
+	return len(g.reachableFrom(start, func(from, to *Node, weight int) bool { return true })) == len(g.edges)
+}
+
+// IsStronglyConnected - проверяет, что из любой вершины достижима любая другая:
+// все вершины достижимы из одной вершины и в графе, и в графе с обращенными дугами.
+// Для неориентированного графа совпадает с IsConnected. Пустой граф считается связным
+func (g *Graph) IsStronglyConnected() bool {
+	if !g.is_oriented {
+		return g.IsConnected()
+	}
+	start := g.anyNode()
+	if start == nil {
+		return true
+	}
+	all := func(from, to *Node, weight int) bool { return true }
+	if len(g.reachableFrom(start, all)) != len(g.edges) {
+		return false
+	}
+	transposed := g.Transpose()
+	return len(transposed.reachableFrom(transposed.getRefOfNode(start.toString()), all)) == len(g.edges)
+}
+
+// IsWeaklyConnected - проверяет связность графа без учета направления дуг.
+// Пустой граф считается связным
+func (g *Graph) IsWeaklyConnected() bool {
+	start := g.anyNode()
+	if start == nil {
+		return true
+	}
+	// Соседи без учета направления
+	neighbors := make(map[*Node][]*Node, len(g.edges))
+	for node, v := range g.edges {
+		for next := range v {
+			neighbors[node] = append(neighbors[node], next)
+			neighbors[next] = append(neighbors[next], node)
+		}
+	}
+	visited := map[*Node]bool{start: true}
+	queue := []*Node{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range neighbors[current] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return len(visited) == len(g.edges)
+}
+
+// stNumbering - строит st-нумерацию двусвязного неориентированного графа (алгоритм Тарьяна):
+// s получает номер 0, t - последний номер, а у каждой другой вершины есть соседи с меньшим и большим номерами.
+// t должна быть соседом s. Возвращает false, если граф не является двусвязным
+func (g *Graph) stNumbering(s, t *Node) (map[*Node]int, bool) {
+	pre := make(map[*Node]int, len(g.edges)) // порядок обхода в глубину
+	parent := make(map[*Node]*Node, len(g.edges))
+	low := make(map[*Node]*Node, len(g.edges)) // вершина с наименьшим pre, достижимая из поддерева одним обратным ребром
+	order := []*Node{}
+	biconnected := true
+
+	var dfs func(v *Node)
+	dfs = func(v *Node) {
+		pre[v] = len(order)
+		order = append(order, v)
+		low[v] = v
+		neighbors := g.sortedNeighbors(v)
+		if v == s {
+			neighbors = []*Node{t} // первым ребром обхода должно быть s - t
+		}
+		for _, next := range neighbors {
+			if _, ok := pre[next]; !ok {
+				parent[next] = v
+				dfs(next)
+				if pre[low[next]] < pre[low[v]] {
+					low[v] = low[next]
+				}
+				// Вершина v разрывает граф, если поддерево next не связано с предками v
+				if v != s && pre[low[next]] >= pre[v] {
+					biconnected = false
+				}
+			} else if next != parent[v] && pre[next] < pre[low[v]] {
+				low[v] = next
+			}
+		}
+	}
+	dfs(s)
+	// Из s обход идет только через t, поэтому вершины, недостижимые без s, останутся непосещенными
+	if len(order) != len(g.edges) || !biconnected {
+		return nil, false
+	}
+
+	// Вставка вершин в список в порядке обхода
+	sign := map[*Node]bool{s: false} // false - "минус", true - "плюс"
+	next := map[*Node]*Node{s: t}
+	prev := map[*Node]*Node{t: s}
+	for _, v := range order[2:] {
+		p := parent[v]
+		if !sign[low[v]] {
+			// вставка перед p
+			prev[v], next[v] = prev[p], p
+			next[prev[p]] = v
+			prev[p] = v
+			sign[p] = true
+		} else {
+			// вставка после p
+			prev[v], next[v] = p, next[p]
+			if next[p] != nil {
+				prev[next[p]] = v
+			}
+			next[p] = v
+			sign[p] = false
+		}
+	}
+
+	numbers := make(map[*Node]int, len(order))
+	for v, i := s, 0; v != nil; v, i = next[v], i+1 {
+		numbers[v] = i
+	}
+	return numbers, true
+}
+
+// IndependentSpanningTrees - для двусвязного неориентированного графа строит два остовных дерева с корнем root,
+// в которых пути от корня до любой вершины не имеют общих внутренних вершин (отказоустойчивая рассылка).
+// Строится st-нумерация с s = root: в первом дереве родитель вершины - сосед с меньшим номером,
+// во втором - сосед с большим номером (для t - сам корень). Возвращает ошибку, если граф не двусвязный
+func (g *Graph) IndependentSpanningTrees(root string) (*Graph, *Graph, error) {
+	if g.is_oriented {
+		return nil, nil, errors.New("Граф должен быть неориентированным")
+	}
+	if err := validateNode(g, root); err != nil {
+		return nil, nil, err
+	}
+	s := g.getRefOfNode(root)
+	neighbors := g.sortedNeighbors(s)
+	if len(neighbors) == 0 {
+		return nil, nil, errors.New("Граф не является двусвязным")
+	}
+	t := neighbors[0]
+	numbers, ok := g.stNumbering(s, t)
+	if !ok {
+		return nil, nil, errors.New("Граф не является двусвязным")
+	}
+
+	lower, upper := NewEmptyGraph(), NewEmptyGraph()
+	for _, tree := range []*Graph{lower, upper} {
+		tree.is_oriented = false
+		tree.is_suspended = g.is_suspended
+		tree.addNode(root)
+	}
+	for v := range g.edges {
+		if v == s {
+			continue
+		}
+		var down, up *Node
+		for _, next := range g.sortedNeighbors(v) {
+			if numbers[next] < numbers[v] && down == nil {
+				down = next
+			}
+			if numbers[next] > numbers[v] && up == nil {
+				up = next
+			}
+		}
+		if v == t {
+			up = s
+		}
+		lower.AddEdge(v.toString(), down.toString(), g.edges[v][down])
+		upper.AddEdge(v.toString(), up.toString(), g.edges[v][up])
+	}
+	return lower, upper, nil
+}
+
+// linkCounts - возвращает для каждой пары соседних вершин количество связей между ними без учета направления
+// (параллельные связи мультиграфа и встречные дуги орграфа считаются отдельно, петли не учитываются)
+func (g *Graph) linkCounts() map[*Node]map[*Node]int {
+	counts := make(map[*Node]map[*Node]int, len(g.edges))
+	for node := range g.edges {
+		counts[node] = make(map[*Node]int)
+	}
+	for node, v := range g.edges {
+		for next := range v {
+			if next == node || !g.is_oriented && next.toString() < node.toString() {
+				continue
+			}
+			n := len(g.weightsBetween(node, next))
+			counts[node][next] += n
+			counts[next][node] += n
+		}
+	}
+	return counts
+}
+
+// sortedKeys - возвращает вершины-ключи отображения, отсортированные по значению
+func sortedKeys(m map[*Node]int) []*Node {
+	result := make([]*Node, 0, len(m))
+	for node := range m {
+		result = append(result, node)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].toString() < result[j].toString()
+	})
+	return result
+}
+
+// sortPairs - сортирует пары значений лексикографически
+func sortPairs(pairs [][2]string) {
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+}
+
+// Bridges - возвращает мосты графа: ребра, после удаления которых увеличивается число компонент связности.
+// Ищутся обходом в глубину со значениями low (наименьший номер обхода, достижимый из поддерева одним обратным ребром).
+// Направление дуг не учитывается, связь с параллельной связью мостом не является, петли пропускаются.
+// Каждый мост возвращается парой значений в порядке возрастания, пары отсортированы
+func (g *Graph) Bridges() [][2]string {
+	counts := g.linkCounts()
+	pre := make(map[*Node]int, len(g.edges))
+	low := make(map[*Node]int, len(g.edges))
+	result := [][2]string{}
+
+	var dfs func(v, parent *Node)
+	dfs = func(v, parent *Node) {
+		pre[v] = len(pre)
+		low[v] = pre[v]
+		for _, next := range sortedKeys(counts[v]) {
+			if next == parent && counts[v][next] == 1 {
+				continue // ребро дерева обхода, по которому пришли в v
+			}
+			if _, ok := pre[next]; ok {
+				if pre[next] < low[v] {
+					low[v] = pre[next]
+				}
+				continue
+			}
+			dfs(next, v)
+			if low[next] < low[v] {
+				low[v] = low[next]
+			}
+			// Поддерево next не связано с v и ее предками ничем, кроме ребра v - next
+			if low[next] > pre[v] {
+				a, b := v.toString(), next.toString()
+				if b < a {
+					a, b = b, a
+				}
+				result = append(result, [2]string{a, b})
+			}
+		}
+	}
+	for _, value := range g.nodeValues() {
+		node := g.getRefOfNode(value)
+		if _, ok := pre[node]; !ok {
+			dfs(node, nil)
+		}
+	}
+	sortPairs(result)
+	return result
+}
+
+// TwoEdgeConnectedComponents - разбивает вершины на компоненты реберной двусвязности: наибольшие множества вершин,
+// остающиеся связными после удаления любого одного ребра. Компоненты - компоненты связности графа без мостов
+// (см. Bridges), направление дуг не учитывается. Каждая компонента отсортирована по значениям,
+// компоненты упорядочены по наименьшей вершине. Изолированная вершина образует отдельную компоненту
+func (g *Graph) TwoEdgeConnectedComponents() [][]string {
+	counts := g.linkCounts()
+	for _, bridge := range g.Bridges() {
+		a, b := g.getRefOfNode(bridge[0]), g.getRefOfNode(bridge[1])
+		delete(counts[a], b)
+		delete(counts[b], a)
+	}
+	visited := make(map[*Node]bool, len(g.edges))
+	result := [][]string{}
+	for _, value := range g.nodeValues() {
+		start := g.getRefOfNode(value)
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		component := []string{}
+		for queue := []*Node{start}; len(queue) > 0; queue = queue[1:] {
+			component = append(component, queue[0].toString())
+			for next := range counts[queue[0]] {
+				if !visited[next] {
+					visited[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+		sort.Strings(component)
+		result = append(result, component)
+	}
+	return result
+}
+
+// BiconnectedComponents - возвращает блоки графа (компоненты двусвязности): наибольшие подграфы без точек сочленения,
+// каждый блок - множество его ребер. Ищутся обходом в глубину со значениями low и стеком ребер:
+// когда поддерево next не связано с предками v, ребра из стека до ребра v - next включительно образуют блок.
+// Направление дуг не учитывается, параллельные связи дают одно ребро, петли пропускаются.
+// Изолированные вершины (и вершины только с петлями) ребер не имеют и ни в один блок не входят.
+// Ребро задается парой значений в порядке возрастания, ребра блока отсортированы, блоки упорядочены по первому ребру
+func (g *Graph) BiconnectedComponents() [][][2]string {
+	counts := g.linkCounts()
+	pre := make(map[*Node]int, len(g.edges))
+	low := make(map[*Node]int, len(g.edges))
+	stack := [][2]*Node{}
+	result := [][][2]string{}
+
+	var dfs func(v, parent *Node)
+	dfs = func(v, parent *Node) {
+		pre[v] = len(pre)
+		low[v] = pre[v]
+		for _, next := range sortedKeys(counts[v]) {
+			if next == parent {
+				continue // ребро дерева обхода уже в стеке, параллельные ему связи блок не меняют
+			}
+			if _, ok := pre[next]; ok {
+				// Обратное ребро учитывается со стороны потомка, чтобы попасть в стек один раз
+				if pre[next] < pre[v] {
+					stack = append(stack, [2]*Node{v, next})
+					if pre[next] < low[v] {
+						low[v] = pre[next]
+					}
+				}
+				continue
+			}
+			stack = append(stack, [2]*Node{v, next})
+			dfs(next, v)
+			if low[next] < low[v] {
+				low[v] = low[next]
+			}
+			if low[next] >= pre[v] {
+				block := [][2]string{}
+				for {
+					e := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					a, b := e[0].toString(), e[1].toString()
+					if b < a {
+						a, b = b, a
+					}
+					block = append(block, [2]string{a, b})
+					if e[0] == v && e[1] == next {
+						break
+					}
+				}
+				sortPairs(block)
+				result = append(result, block)
+			}
+		}
+	}
+	for _, value := range g.nodeValues() {
+		node := g.getRefOfNode(value)
+		if _, ok := pre[node]; !ok {
+			dfs(node, nil)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i][0][0] != result[j][0][0] {
+			return result[i][0][0] < result[j][0][0]
+		}
+		return result[i][0][1] < result[j][0][1]
+	})
+	return result
+}
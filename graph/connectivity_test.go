@@ -0,0 +1,144 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// checkSpanningTree - проверяет, что tree - остовное дерево графа g
+func checkSpanningTree(t *testing.T, g, tree *Graph) {
+	t.Helper()
+	if len(tree.edges) != len(g.edges) || len(tree.Edges()) != len(g.edges)-1 || !tree.IsConnected() {
+		t.Fatalf("дерево %v не является остовным", tree.Edges())
+	}
+	for _, e := range tree.Edges() {
+		if _, ok := g.edgeWeight(e.From, e.To); !ok {
+			t.Errorf("ребра %s - %s нет в графе", e.From, e.To)
+		}
+	}
+}
+
+func TestIndependentSpanningTrees(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *Graph
+		root    string
+		wantErr bool
+	}{
+		{"цикл", NewCycleGraph(6), "0", false},
+		{"цикл, корень в середине", NewCycleGraph(5), "3", false},
+		{"полный граф", NewCompleteGraph([]string{"a", "b", "c", "d", "e"}), "c", false},
+		{"решетка", NewGridGraph(3, 3), "1,1", false},
+		{"путь", NewPathGraph(4), "0", true},
+		{"два цикла с общей вершиной", mustGraph(t, false, false, [][3]string{
+			{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"d", "e"}, {"e", "c"},
+		}), "a", true},
+		{"ориентированный граф", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "a"}}), "a", true},
+		{"нет вершины", NewCycleGraph(3), "x", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second, err := tt.g.IndependentSpanningTrees(tt.root)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IndependentSpanningTrees() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			checkSpanningTree(t, tt.g, first)
+			checkSpanningTree(t, tt.g, second)
+			// Пути от корня в двух деревьях не имеют общих внутренних вершин
+			for _, v := range tt.g.nodeValues() {
+				if v == tt.root {
+					continue
+				}
+				path1, _, err1 := first.shortestPath(tt.root, v)
+				path2, _, err2 := second.shortestPath(tt.root, v)
+				if err1 != nil || err2 != nil {
+					t.Fatalf("нет пути до %s: %v, %v", v, err1, err2)
+				}
+				inner := map[string]bool{}
+				for _, u := range path1[1 : len(path1)-1] {
+					inner[u] = true
+				}
+				for _, u := range path2[1 : len(path2)-1] {
+					if inner[u] {
+						t.Errorf("пути %v и %v до %s пересекаются в %s", path1, path2, v, u)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		edges    [][3]string
+		isolated []string
+		want     [][][2]string
+	}{
+		{"два треугольника с общей вершиной и мост", false,
+			[][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"d", "e"}, {"e", "c"}, {"e", "f"}}, []string{"z"},
+			[][][2]string{{{"a", "b"}, {"a", "c"}, {"b", "c"}}, {{"c", "d"}, {"c", "e"}, {"d", "e"}}, {{"e", "f"}}}},
+		{"путь", false, [][3]string{{"a", "b"}, {"b", "c"}}, nil,
+			[][][2]string{{{"a", "b"}}, {{"b", "c"}}}},
+		{"направление дуг не учитывается", true, [][3]string{{"a", "b"}, {"b", "c"}, {"a", "c"}}, nil,
+			[][][2]string{{{"a", "b"}, {"a", "c"}, {"b", "c"}}}},
+		{"петля пропускается", false, [][3]string{{"a", "a"}, {"a", "b"}, {"c", "c"}}, nil,
+			[][][2]string{{{"a", "b"}}}},
+		{"только изолированные вершины", false, nil, []string{"a", "b"}, [][][2]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, false, tt.edges)
+			for _, v := range tt.isolated {
+				g.AddNode(v)
+			}
+			if got := g.BiconnectedComponents(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BiconnectedComponents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBridges(t *testing.T) {
+	multigraph := NewEmptyMultigraph()
+	multigraph.is_oriented = false
+	multigraph.AddEdge("a", "b", 1)
+	multigraph.AddEdge("a", "b", 2)
+	multigraph.AddEdge("b", "c", 1)
+	tests := []struct {
+		name           string
+		g              *Graph
+		isolated       []string
+		wantBridges    [][2]string
+		wantComponents [][]string
+	}{
+		{"два цикла, соединенные мостом", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"d", "e"}, {"e", "f"}, {"f", "d"}}), []string{"z"},
+			[][2]string{{"c", "d"}}, [][]string{{"a", "b", "c"}, {"d", "e", "f"}, {"z"}}},
+		{"дерево - каждое ребро мост", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"a", "c"}, {"d", "c"}}), nil,
+			[][2]string{{"a", "b"}, {"a", "c"}, {"c", "d"}}, [][]string{{"a"}, {"b"}, {"c"}, {"d"}}},
+		{"параллельное ребро не мост", multigraph, nil,
+			[][2]string{{"b", "c"}}, [][]string{{"a", "b"}, {"c"}}},
+		{"направление дуг не учитывается", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}}), nil,
+			[][2]string{{"c", "d"}}, [][]string{{"a", "b", "c"}, {"d"}}},
+		{"петля пропускается", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "b"}}), nil,
+			[][2]string{{"a", "b"}}, [][]string{{"a"}, {"b"}}},
+		{"пустой граф", NewEmptyGraph(), nil, [][2]string{}, [][]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range tt.isolated {
+				tt.g.AddNode(v)
+			}
+			if got := tt.g.Bridges(); !reflect.DeepEqual(got, tt.wantBridges) {
+				t.Errorf("Bridges() = %v, want %v", got, tt.wantBridges)
+			}
+			if got := tt.g.TwoEdgeConnectedComponents(); !reflect.DeepEqual(got, tt.wantComponents) {
+				t.Errorf("TwoEdgeConnectedComponents() = %v, want %v", got, tt.wantComponents)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package graph
+
+import "sort"
+
+/*
+
+k-ядра:
+- KCore - наибольший подграф, в котором степени всех вершин не меньше k
+- CoreNumbers - номер ядра каждой вершины
+
+*/
+
+// peelOrder - последовательно удаляет вершину наименьшей степени (в смысле Degree, степени пересчитываются
+// после каждого удаления) и возвращает вершины в порядке удаления вместе с их степенями в момент удаления.
+// При равных степенях раньше удаляется меньшая по значению вершина
+func (g *Graph) peelOrder() ([]*Node, []int) {
+	degree := make(map[*Node]int, len(g.edges))
+	incoming := make(map[*Node][]*Node, len(g.edges))
+	for node, v := range g.edges {
+		degree[node] = g.Degree(node.toString())
+		for next := range v {
+			if next != node {
+				incoming[next] = append(incoming[next], node)
+			}
+		}
+	}
+	removed := make(map[*Node]bool, len(g.edges))
+	order := make([]*Node, 0, len(g.edges))
+	degrees := make([]int, 0, len(g.edges))
+	for len(order) < len(g.edges) {
+		var best *Node
+		for node := range g.edges {
+			if removed[node] {
+				continue
+			}
+			if best == nil || degree[node] < degree[best] ||
+				degree[node] == degree[best] && node.toString() < best.toString() {
+				best = node
+			}
+		}
+		removed[best] = true
+		order = append(order, best)
+		degrees = append(degrees, degree[best])
+		for next := range g.edges[best] {
+			if next != best && !removed[next] {
+				degree[next]--
+			}
+		}
+		if g.is_oriented {
+			for _, prev := range incoming[best] {
+				if !removed[prev] {
+					degree[prev]--
+				}
+			}
+		}
+	}
+	return order, degrees
+}
+
+// CoreNumbers - возвращает номер ядра каждой вершины: наибольшее k, при котором вершина входит в k-ядро
+func (g *Graph) CoreNumbers() map[string]int {
+	order, degrees := g.peelOrder()
+	result := make(map[string]int, len(order))
+	core := 0
+	for i, node := range order {
+		if degrees[i] > core {
+			core = degrees[i]
+		}
+		result[node.toString()] = core
+	}
+	return result
+}
+
+// KCore - возвращает k-ядро: наибольший подграф, в котором степень каждой вершины (в смысле Degree) не меньше k.
+// Совпадает с результатом повторного удаления вершин степени меньше k (аналогично повторному удалению
+// нечетных вершин) и состоит из вершин, номер ядра которых не меньше k.
+// Ориентированность и взвешенность сохраняются, если ядро пусто, возвращает пустой граф
+func (g *Graph) KCore(k int) *Graph {
+	nodes := []string{}
+	for value, core := range g.CoreNumbers() {
+		if core >= k {
+			nodes = append(nodes, value)
+		}
+	}
+	sort.Strings(nodes)
+	result, _ := g.InducedSubgraph(nodes)
+	return result
+}
@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCoreNumbers(t *testing.T) {
+	// Треугольник a, b, c с висячей вершиной p, связанный ребром c - w с K_4 на w, x, y, z, и изолированная вершина i
+	g := mustGraph(t, false, false, [][3]string{
+		{"a", "b"}, {"b", "c"}, {"c", "a"}, {"a", "p"}, {"c", "w"},
+		{"w", "x"}, {"w", "y"}, {"w", "z"}, {"x", "y"}, {"x", "z"}, {"y", "z"},
+	})
+	g.AddNode("i")
+	want := map[string]int{"a": 2, "b": 2, "c": 2, "p": 1, "w": 3, "x": 3, "y": 3, "z": 3, "i": 0}
+	if got := g.CoreNumbers(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CoreNumbers() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name string
+		k    int
+		want []string
+	}{
+		{"k = 0 - весь граф", 0, []string{"a", "b", "c", "i", "p", "w", "x", "y", "z"}},
+		{"k = 1", 1, []string{"a", "b", "c", "p", "w", "x", "y", "z"}},
+		{"k = 2", 2, []string{"a", "b", "c", "w", "x", "y", "z"}},
+		{"k = 3 - K_4", 3, []string{"w", "x", "y", "z"}},
+		{"k больше наибольшей степени", 10, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core := g.KCore(tt.k)
+			if got := core.nodeValues(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KCore(%d) = %v, want %v", tt.k, got, tt.want)
+			}
+			// Ядро состоит ровно из вершин с номером ядра не меньше k, и их степени в ядре не меньше k
+			expected := []string{}
+			for value, c := range want {
+				if c >= tt.k {
+					expected = append(expected, value)
+				}
+			}
+			sort.Strings(expected)
+			if !reflect.DeepEqual(core.nodeValues(), expected) {
+				t.Errorf("KCore(%d) = %v, вершины с номером ядра >= k: %v", tt.k, core.nodeValues(), expected)
+			}
+			for _, value := range core.nodeValues() {
+				if d := core.Degree(value); d < tt.k {
+					t.Errorf("степень %s в KCore(%d) = %d", value, tt.k, d)
+				}
+			}
+		})
+	}
+
+	// В орграфе степень - сумма полустепеней
+	cycle := mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}})
+	if got, want := cycle.CoreNumbers(), map[string]int{"a": 2, "b": 2, "c": 2, "d": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CoreNumbers() орграфа = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+/*
+
+Импорт и экспорт матрицы смежности в формате CSV.
+Первая строка и первый столбец содержат названия узлов, остальные ячейки - веса дуг / ребер.
+В левой верхней ячейке записываются ориентированность и взвешенность графа
+в тех же обозначениях, что и в файловом формате ("oriented suspended"),
+пустая ячейка означает ориентированный взвешенный граф.
+Отсутствие связи обозначается маркером noEdge (по умолчанию "0") или пустой ячейкой,
+для невзвешенного графа наличие связи обозначается единицей.
+
+*/
+
+// defaultNoEdge - маркер отсутствия связи по умолчанию
+const defaultNoEdge = "0"
+
+// FromAdjacencyMatrixCSV - создает граф из матрицы смежности в формате CSV
+func FromAdjacencyMatrixCSV(r io.Reader) (*Graph, error) {
+	return FromAdjacencyMatrixCSVWithMarker(r, defaultNoEdge)
+}
+
+// FromAdjacencyMatrixCSVWithMarker - создает граф из матрицы смежности в формате CSV,
+// noEdge - значение ячейки, означающее отсутствие связи. Если названия узлов в заголовке повторяются, возвращает ошибку
+func FromAdjacencyMatrixCSVWithMarker(r io.Reader, noEdge string) (*Graph, error) {
+	records, err := csv.NewReader(r).ReadAll() // строки разной длины приводят к ошибке
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("Матрица смежности пуста")
+	}
+	g := NewEmptyGraph()
+	for _, flag := range strings.Fields(records[0][0]) {
+		switch flag {
+		case "oriented":
+			g.is_oriented = true
+		case "unoriented":
+			g.is_oriented = false
+		case "suspended":
+			g.is_suspended = true
+		case "unsuspended":
+			g.is_suspended = false
+		default:
+			return nil, errors.New("Неизвестный тип графа: " + flag)
+		}
+	}
+	labels := records[0][1:]
+	if len(records)-1 != len(labels) {
+		return nil, errors.New("Матрица смежности должна быть квадратной")
+	}
+	for i, label := range labels {
+		if g.getRefOfNode(label) != nil {
+			return nil, fmt.Errorf("Столбец %d: узел %s повторяется в заголовке", i+2, label)
+		}
+		g.addNode(label)
+	}
+
+	weights := make([][]int, len(labels))
+	present := make([][]bool, len(labels))
+	for i, row := range records[1:] {
+		if row[0] != labels[i] {
+			return nil, fmt.Errorf("Строка %d: узел %s не совпадает с узлом столбца %s", i+2, row[0], labels[i])
+		}
+		weights[i] = make([]int, len(labels))
+		present[i] = make([]bool, len(labels))
+		for j, cell := range row[1:] {
+			cell = strings.TrimSpace(cell)
+			if cell == "" || cell == noEdge {
+				continue
+			}
+			w, err := strconv.Atoi(cell)
+			if err != nil {
+				return nil, fmt.Errorf("Строка %d, столбец %d: %w", i+2, j+2, err)
+			}
+			weights[i][j] = w
+			present[i][j] = true
+		}
+	}
+
+	for i := range labels {
+		for j := range labels {
+			if !present[i][j] {
+				continue
+			}
+			if !g.is_oriented && (!present[j][i] || weights[j][i] != weights[i][j]) {
+				return nil, errors.New("Матрица смежности неориентированного графа должна быть симметричной")
+			}
+			g.AddEdge(labels[i], labels[j], weights[i][j])
+		}
+	}
+	return g, nil
+}
+
+// ToAdjacencyMatrixCSV - записывает матрицу смежности графа в формате CSV
+func (g *Graph) ToAdjacencyMatrixCSV(w io.Writer) error {
+	return g.ToAdjacencyMatrixCSVWithMarker(w, defaultNoEdge)
+}
+
+// ToAdjacencyMatrixCSVWithMarker - записывает матрицу смежности графа в формате CSV,
+// noEdge - значение ячейки, означающее отсутствие связи. Если значение какой-то связи совпадает с noEdge
+// (например, вес 0 при маркере по умолчанию), связь при чтении потерялась бы, поэтому ничего не записывается
+// и возвращается ошибка
+func (g *Graph) ToAdjacencyMatrixCSVWithMarker(w io.Writer, noEdge string) error {
+	labels := g.nodeValues()
+	nodes := make([]*Node, len(labels))
+	for i, label := range labels {
+		nodes[i] = g.getRefOfNode(label)
+	}
+
+	header := "oriented"
+	if !g.is_oriented {
+		header = "unoriented"
+	}
+	if g.is_suspended {
+		header += " suspended"
+	} else {
+		header += " unsuspended"
+	}
+
+	// Строки собираются заранее, чтобы при ошибке не записать часть матрицы
+	rows := [][]string{append([]string{header}, labels...)}
+	for i, node := range nodes {
+		row := []string{labels[i]}
+		for j, other := range nodes {
+			d, ok := g.edges[node][other]
+			if !ok {
+				row = append(row, noEdge)
+				continue
+			}
+			cell := "1"
+			if g.is_suspended {
+				cell = strconv.Itoa(d)
+			}
+			if cell == strings.TrimSpace(noEdge) {
+				return fmt.Errorf("Связь %s - %s записалась бы как маркер отсутствия связи %q", labels[i], labels[j], noEdge)
+			}
+			row = append(row, cell)
+		}
+		rows = append(rows, row)
+	}
+
+	writer := csv.NewWriter(w)
+	for _, row := range rows {
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
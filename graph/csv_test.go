@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestAdjacencyMatrixCSVRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		weighted bool
+		marker   string
+		edges    [][3]string
+		isolated []string
+	}{
+		{"ориентированный взвешенный", true, true, "0", [][3]string{{"a", "b", "3"}, {"b", "a", "5"}, {"b", "c", "-2"}}, nil},
+		{"неориентированный невзвешенный", false, false, "0", [][3]string{{"a", "b"}, {"b", "c"}, {"c", "c"}}, []string{"d"}},
+		{"нулевые веса с маркером", true, true, "-", [][3]string{{"a", "b", "0"}, {"b", "c", "7"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, tt.weighted, tt.edges)
+			for _, value := range tt.isolated {
+				g.AddNode(value)
+			}
+			var buf bytes.Buffer
+			if err := g.ToAdjacencyMatrixCSVWithMarker(&buf, tt.marker); err != nil {
+				t.Fatalf("ToAdjacencyMatrixCSVWithMarker() error = %v", err)
+			}
+			restored, err := FromAdjacencyMatrixCSVWithMarker(&buf, tt.marker)
+			if err != nil {
+				t.Fatalf("FromAdjacencyMatrixCSVWithMarker() error = %v", err)
+			}
+			if !g.Equal(restored) {
+				t.Errorf("граф после CSV не совпадает с исходным: %v, want %v", restored.Edges(), g.Edges())
+			}
+		})
+	}
+}
+
+func TestFromAdjacencyMatrixCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+		want    int
+	}{
+		{"пустая ячейка - ориентированный взвешенный", ",a,b\na,0,4\nb,0,0\n", false, 1},
+		{"пустая ячейка - нет связи", "unoriented suspended,a,b\na,,2\nb,2,\n", false, 1},
+		{"пустой ввод", "", true, 0},
+		{"строки разной длины", ",a,b\na,0\nb,0,0\n", true, 0},
+		{"нечисловая ячейка", ",a,b\na,0,x\nb,0,0\n", true, 0},
+		{"матрица не квадратная", ",a,b\na,0,1\n", true, 0},
+		{"узел строки не совпадает", ",a,b\nb,0,1\na,0,0\n", true, 0},
+		{"неизвестный тип", "weird,a\na,0\n", true, 0},
+		{"несимметричная для неориентированного", "unoriented suspended,a,b\na,0,1\nb,0,0\n", true, 0},
+		{"повторяющийся узел в заголовке", ",a,a\na,0,1\na,0,0\n", true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := FromAdjacencyMatrixCSV(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromAdjacencyMatrixCSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(g.Edges()) != tt.want {
+				t.Errorf("len(Edges()) = %d, want %d", len(g.Edges()), tt.want)
+			}
+		})
+	}
+}
+
+func TestToAdjacencyMatrixCSVMarkerWeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		weighted bool
+		marker   string
+		edges    [][3]string
+		wantErr  bool
+	}{
+		{"нулевой вес при маркере по умолчанию", true, "0", [][3]string{{"a", "b", "0"}, {"b", "c", "2"}}, true},
+		{"вес совпадает с маркером", true, "7", [][3]string{{"a", "b", "7"}}, true},
+		{"маркер 1 в невзвешенном графе", false, "1", [][3]string{{"a", "b"}}, true},
+		{"нулевой вес при другом маркере", true, "-", [][3]string{{"a", "b", "0"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := mustGraph(t, true, tt.weighted, tt.edges).ToAdjacencyMatrixCSVWithMarker(&buf, tt.marker)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToAdjacencyMatrixCSVWithMarker() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && buf.Len() != 0 {
+				t.Errorf("при ошибке записано %q", buf.String())
+			}
+		})
+	}
+}
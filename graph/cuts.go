@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+/*
+
+Разрезы графа:
+- KargerMinCut - минимальный разрез рандомизированным алгоритмом Каргера
+
+*/
+
+// KargerMinCut - ищет минимальный разрез неориентированного графа алгоритмом Каргера,
+// используя генератор случайных чисел, инициализированный текущим временем (см. KargerMinCutRand)
+func (g *Graph) KargerMinCut(iterations int) ([][2]string, int) {
+	return g.KargerMinCutRand(iterations, rand.New(rand.NewSource(time.Now().UnixNano())))
+}
+
+// KargerMinCutRand - ищет минимальный разрез алгоритмом Каргера: в каждой из iterations попыток
+// случайные ребра стягиваются, пока не останется две супервершины, и из найденных разрезов выбирается
+// наименьший. Возвращает ребра разреза и его величину (сумму весов, для невзвешенного графа - число ребер).
+// Стягивание выполняется через систему непересекающихся множеств, что равносильно ContractEdge
+// с сохранением кратных ребер. Очередное ребро выбирается с вероятностью, пропорциональной его весу
+// (для невзвешенного графа - равновероятно), поэтому оценка Каргера верна и для взвешенного разреза:
+// каждому ребру назначается экспоненциально распределенный ключ с интенсивностью, равной весу, и ребра
+// стягиваются по возрастанию ключей. Ребра с неположительным весом стягиваются последними.
+// Результат верен лишь с высокой вероятностью, которая растет с числом попыток
+// (для n вершин достаточно порядка n^2 * ln n попыток). Направление дуг орграфа не учитывается.
+// Переданный rng позволяет получать воспроизводимые результаты
+func (g *Graph) KargerMinCutRand(iterations int, rng *rand.Rand) ([][2]string, int) {
+	values := g.nodeValues()
+	edges := []Edge{}
+	for _, e := range g.edgeList() {
+		if e.From != e.To {
+			edges = append(edges, e)
+		}
+	}
+	if len(values) < 2 {
+		return nil, 0
+	}
+	if iterations < 1 {
+		iterations = 1
+	}
+
+	var best [][2]string
+	bestValue := -1
+	for i := 0; i < iterations; i++ {
+		parent := make(map[string]string, len(values))
+		for _, value := range values {
+			parent[value] = value
+		}
+		var find func(x string) string
+		find = func(x string) string {
+			if parent[x] != x {
+				parent[x] = find(parent[x])
+			}
+			return parent[x]
+		}
+
+		// Стягиваем ребра в случайном порядке, пока не останется две супервершины
+		components := len(values)
+		for _, j := range weightedOrder(edges, g.cost, rng) {
+			if components == 2 {
+				break
+			}
+			a, b := find(edges[j].From), find(edges[j].To)
+			if a != b {
+				parent[a] = b
+				components--
+			}
+		}
+		// Несвязный граф: оставшиеся компоненты объединяются произвольно, разрез пуст
+		for _, value := range values[1:] {
+			if components == 2 {
+				break
+			}
+			a, b := find(values[0]), find(value)
+			if a != b {
+				parent[b] = a
+				components--
+			}
+		}
+
+		cut := [][2]string{}
+		cutValue := 0
+		for _, e := range edges {
+			if find(e.From) != find(e.To) {
+				cut = append(cut, [2]string{e.From, e.To})
+				cutValue += g.cost(e.Weight)
+			}
+		}
+		if bestValue == -1 || cutValue < bestValue {
+			best, bestValue = cut, cutValue
+		}
+	}
+	sort.Slice(best, func(i, j int) bool {
+		if best[i][0] != best[j][0] {
+			return best[i][0] < best[j][0]
+		}
+		return best[i][1] < best[j][1]
+	})
+	return best, bestValue
+}
+
+// weightedOrder - возвращает случайный порядок индексов ребер, в котором каждое следующее ребро среди оставшихся
+// выбирается с вероятностью, пропорциональной весу cost(Weight): ключ ребра распределен экспоненциально
+// с интенсивностью, равной весу, и минимум таких ключей приходится на ребро пропорционально весу.
+// Ребра с неположительным весом получают бесконечный ключ и идут последними в случайном порядке
+func weightedOrder(edges []Edge, cost func(int) int, rng *rand.Rand) []int {
+	order := rng.Perm(len(edges))
+	keys := make([]float64, len(edges))
+	for i, e := range edges {
+		if w := cost(e.Weight); w > 0 {
+			keys[i] = rng.ExpFloat64() / float64(w)
+		} else {
+			keys[i] = math.Inf(1)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return keys[order[a]] < keys[order[b]]
+	})
+	return order
+}
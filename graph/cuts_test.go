@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestKargerMinCutRand(t *testing.T) {
+	tests := []struct {
+		name     string
+		weighted bool
+		edges    [][3]string
+		want     int
+	}{
+		{"мост между треугольниками", false, [][3]string{
+			{"a", "b"}, {"b", "c"}, {"c", "a"}, {"d", "e"}, {"e", "f"}, {"f", "d"}, {"c", "d"},
+		}, 1},
+		{"цикл", false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "a"}}, 2},
+		{"взвешенный треугольник", true, [][3]string{{"a", "b", "100"}, {"b", "c", "1"}, {"c", "a", "1"}}, 2},
+		{"петли не входят в разрез", false, [][3]string{{"a", "a"}, {"a", "b"}, {"b", "b"}}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, false, tt.weighted, tt.edges)
+			cut, value := g.KargerMinCutRand(50, rand.New(rand.NewSource(1)))
+			if value != tt.want {
+				t.Errorf("KargerMinCutRand() value = %d, want %d (cut %v)", value, tt.want, cut)
+			}
+		})
+	}
+}
+
+func TestKargerMinCutRandWeightedChoice(t *testing.T) {
+	// При равновероятном выборе одна попытка находит разрез {c} лишь в трети случаев,
+	// при выборе пропорционально весу - почти всегда стягивается тяжелое ребро a - b
+	g := mustGraph(t, false, true, [][3]string{{"a", "b", "100"}, {"b", "c", "1"}, {"c", "a", "1"}})
+	found := 0
+	for seed := int64(0); seed < 100; seed++ {
+		if _, value := g.KargerMinCutRand(1, rand.New(rand.NewSource(seed))); value == 2 {
+			found++
+		}
+	}
+	if found < 90 {
+		t.Errorf("минимальный разрез найден в %d попытках из 100", found)
+	}
+}
@@ -0,0 +1,193 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+)
+
+/*
+
+Ациклические орграфы:
+- IsDAG - проверка графа на ациклический орграф
+- MaxAntichain - наибольшая антицепь
+- TransitiveReduction - транзитивное сокращение
+
+*/
+
+// topologicalOrder - возвращает вершины орграфа в топологическом порядке (алгоритм Кана)
+// и false, если в графе есть цикл или петля. При равенстве раньше идет меньшая по значению вершина
+func (g *Graph) topologicalOrder() ([]*Node, bool) {
+	inDegree := make(map[*Node]int, len(g.edges))
+	for _, v := range g.edges {
+		for next := range v {
+			inDegree[next]++
+		}
+	}
+	ready := []string{}
+	for node := range g.edges {
+		if inDegree[node] == 0 {
+			ready = append(ready, node.toString())
+		}
+	}
+	order := make([]*Node, 0, len(g.edges))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		node := g.getRefOfNode(ready[0])
+		ready = ready[1:]
+		order = append(order, node)
+		for next := range g.edges[node] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				ready = append(ready, next.toString())
+			}
+		}
+	}
+	return order, len(order) == len(g.edges)
+}
+
+// IsDAG - проверяет, является ли граф ациклическим орграфом. Для неориентированного графа
+// всегда возвращает false, граф с циклом или петлей также не является ациклическим
+func (g *Graph) IsDAG() bool {
+	if !g.is_oriented {
+		return false
+	}
+	_, ok := g.topologicalOrder()
+	return ok
+}
+
+// MaxAntichain - для ациклического орграфа находит наибольшую антицепь - множество попарно несравнимых
+// (недостижимых друг из друга) вершин. По теореме Дилворта ее размер равен минимальному числу цепей,
+// покрывающих граф. Строится двудольный граф отношения достижимости, находится наибольшее паросочетание,
+// а антицепь извлекается из минимального вершинного покрытия (теорема Кенига).
+// Возвращает отсортированный список вершин антицепи
+func (g *Graph) MaxAntichain() ([]string, error) {
+	if !g.IsDAG() {
+		return nil, errors.New("Граф должен быть ориентированным и не содержать циклов")
+	}
+
+	// Отношение достижимости: reach[u] - вершины, достижимые из u, кроме нее самой
+	reach := make(map[*Node][]*Node, len(g.edges))
+	for node := range g.edges {
+		for other := range g.reachableFrom(node, func(_, _ *Node, _ int) bool { return true }) {
+			if other != node {
+				reach[node] = append(reach[node], other)
+			}
+		}
+	}
+
+	// Наибольшее паросочетание алгоритмом Куна: matchRight[v] - левая вершина, с которой связана правая v
+	matchRight := make(map[*Node]*Node)
+	matchLeft := make(map[*Node]*Node)
+	var augment func(u *Node, used map[*Node]bool) bool
+	augment = func(u *Node, used map[*Node]bool) bool {
+		for _, v := range reach[u] {
+			if used[v] {
+				continue
+			}
+			used[v] = true
+			if matchRight[v] == nil || augment(matchRight[v], used) {
+				matchRight[v] = u
+				matchLeft[u] = v
+				return true
+			}
+		}
+		return false
+	}
+	for node := range g.edges {
+		augment(node, make(map[*Node]bool))
+	}
+
+	// Вершины, достижимые чередующимися путями из свободных левых вершин
+	visitedLeft := make(map[*Node]bool)
+	visitedRight := make(map[*Node]bool)
+	queue := []*Node{}
+	for node := range g.edges {
+		if matchLeft[node] == nil {
+			visitedLeft[node] = true
+			queue = append(queue, node)
+		}
+	}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, v := range reach[u] {
+			if visitedRight[v] || matchLeft[u] == v {
+				continue
+			}
+			visitedRight[v] = true
+			if w := matchRight[v]; w != nil && !visitedLeft[w] {
+				visitedLeft[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+
+	// Покрытие: непосещенные левые и посещенные правые, антицепь - вершины, не попавшие в покрытие ни одной копией
+	result := []string{}
+	for node := range g.edges {
+		if visitedLeft[node] && !visitedRight[node] {
+			result = append(result, node.toString())
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// TransitiveReduction - для ациклического орграфа возвращает транзитивное сокращение - граф с наименьшим
+// числом дуг и тем же отношением достижимости: дуга u -> w удаляется, если w достижима из u другим,
+// более длинным путем. Достижимость вычисляется алгоритмом Уоршелла, затем дуга u -> w признается лишней,
+// если w достижима из другого конца v дуги u -> v. Сохраняются все вершины (в том числе изолированные)
+// и веса оставшихся дуг (для мультиграфа - все параллельные дуги).
+// Если граф неориентированный или содержит цикл, возвращает ошибку
+func (g *Graph) TransitiveReduction() (*Graph, error) {
+	if !g.IsDAG() {
+		return nil, errors.New("Граф должен быть ориентированным и не содержать циклов")
+	}
+	values := g.nodeValues()
+	nodes := make([]*Node, len(values))
+	index := make(map[*Node]int, len(values))
+	for i, value := range values {
+		nodes[i] = g.getRefOfNode(value)
+		index[nodes[i]] = i
+	}
+	// reach[i][j] - вершина j достижима из i путем из хотя бы одной дуги
+	reach := make([][]bool, len(nodes))
+	for i, node := range nodes {
+		reach[i] = make([]bool, len(nodes))
+		for next := range g.edges[node] {
+			reach[i][index[next]] = true
+		}
+	}
+	for k := range nodes {
+		for i := range nodes {
+			if !reach[i][k] {
+				continue
+			}
+			for j := range nodes {
+				if reach[k][j] {
+					reach[i][j] = true
+				}
+			}
+		}
+	}
+
+	result := newGraphLike(g)
+	for _, value := range values {
+		result.addNode(value)
+	}
+	for _, node := range nodes {
+		for next := range g.edges[node] {
+			redundant := false
+			for other := range g.edges[node] {
+				if other != next && reach[index[other]][index[next]] {
+					redundant = true
+					break
+				}
+			}
+			if !redundant {
+				copyLinks(result, g, node, next, node.toString(), next.toString())
+			}
+		}
+	}
+	return result, nil
+}
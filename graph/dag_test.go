@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxAntichain(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		edges    [][3]string
+		wantSize int
+		wantErr  bool
+	}{
+		{"ромб", true, [][3]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}}, 2, false},
+		{"делители 12", true, [][3]string{{"1", "2"}, {"1", "3"}, {"2", "4"}, {"2", "6"}, {"3", "6"}, {"4", "12"}, {"6", "12"}}, 2, false},
+		{"три источника", true, [][3]string{{"a", "x"}, {"b", "x"}, {"c", "x"}}, 3, false},
+		{"цепь", true, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "d"}}, 1, false},
+		{"цикл", true, [][3]string{{"a", "b"}, {"b", "a"}}, 0, true},
+		{"неориентированный граф", false, [][3]string{{"a", "b"}}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, false, tt.edges)
+			got, err := g.MaxAntichain()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MaxAntichain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantSize {
+				t.Errorf("MaxAntichain() = %v, want %d вершин", got, tt.wantSize)
+			}
+			// Вершины антицепи попарно несравнимы
+			for _, u := range got {
+				reachable, _ := g.Reachable(u)
+				for _, r := range reachable {
+					for _, v := range got {
+						if r == v && u != v {
+							t.Errorf("MaxAntichain() = %v: %s достижима из %s", got, v, u)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	tests := []struct {
+		name      string
+		oriented  bool
+		edges     [][3]string
+		isolated  []string
+		wantEdges []Edge
+		wantErr   bool
+	}{
+		{"лишние дуги и изолированная вершина", true,
+			[][3]string{{"a", "b", "2"}, {"b", "c", "3"}, {"a", "c", "7"}, {"c", "d", "1"}, {"a", "d", "9"}, {"b", "d", "4"}}, []string{"z"},
+			[]Edge{{"a", "b", 2}, {"b", "c", 3}, {"c", "d", 1}}, false},
+		{"ромб без лишних дуг", true, [][3]string{{"a", "b", "1"}, {"a", "c", "2"}, {"b", "d", "3"}, {"c", "d", "4"}}, nil,
+			[]Edge{{"a", "b", 1}, {"a", "c", 2}, {"b", "d", 3}, {"c", "d", 4}}, false},
+		{"цикл", true, [][3]string{{"a", "b", "1"}, {"b", "c", "1"}, {"c", "a", "1"}}, nil, nil, true},
+		{"неориентированный граф", false, [][3]string{{"a", "b", "1"}}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, true, tt.edges)
+			for _, v := range tt.isolated {
+				g.AddNode(v)
+			}
+			reduced, err := g.TransitiveReduction()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TransitiveReduction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := reduced.Edges(); !reflect.DeepEqual(got, tt.wantEdges) {
+				t.Errorf("TransitiveReduction() = %v, want %v", got, tt.wantEdges)
+			}
+			if !reflect.DeepEqual(reduced.nodeValues(), g.nodeValues()) {
+				t.Errorf("вершины = %v, want %v", reduced.nodeValues(), g.nodeValues())
+			}
+			// Достижимость сохраняется
+			for _, v := range g.nodeValues() {
+				before, after := g.BFSLevels(v), reduced.BFSLevels(v)
+				for u := range before {
+					if _, ok := after[u]; !ok {
+						t.Errorf("%s перестала быть достижимой из %s", u, v)
+					}
+				}
+				if len(before) != len(after) {
+					t.Errorf("достижимые из %s: %v, want %v", v, after, before)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package graph
+
+import "errors"
+
+/*
+
+Доминирующие множества:
+- GreedyDominatingSet - жадно строит доминирующее множество
+- DistanceKDominatingSet - жадно строит доминирующее множество для расстояния k
+
+*/
+
+// GreedyDominatingSet - жадно строит доминирующее множество: на каждом шаге выбирается вершина,
+// покрывающая (сама и ее соседи) больше всего еще не покрытых вершин.
+// Для орграфа вершина покрывает концы исходящих дуг. При равенстве выбирается меньшая по значению вершина
+func (g *Graph) GreedyDominatingSet() []string {
+	result, _ := g.DistanceKDominatingSet(1)
+	return result
+}
+
+// DistanceKDominatingSet - жадно строит множество вершин, в котором каждая вершина покрывает все вершины
+// на расстоянии не более k ребер (например, охрана с радиусом действия). При k = 1 это обычное
+// доминирующее множество. Для орграфа расстояние считается по направлению дуг
+func (g *Graph) DistanceKDominatingSet(k int) ([]string, error) {
+	if k < 1 {
+		return nil, errors.New("Расстояние k должно быть не меньше 1")
+	}
+	values := g.nodeValues()
+	// Покрытие каждой вершины - вершины на расстоянии не более k
+	cover := make(map[*Node][]*Node, len(values))
+	for _, value := range values {
+		node := g.getRefOfNode(value)
+		cover[node] = g.nodesWithinHops(node, k)
+	}
+
+	dominated := make(map[*Node]bool, len(g.edges))
+	result := []string{}
+	for len(dominated) < len(g.edges) {
+		var best *Node
+		bestGain := 0
+		for _, value := range values {
+			node := g.getRefOfNode(value)
+			gain := 0
+			for _, next := range cover[node] {
+				if !dominated[next] {
+					gain++
+				}
+			}
+			if gain > bestGain {
+				best = node
+				bestGain = gain
+			}
+		}
+		for _, next := range cover[best] {
+			dominated[next] = true
+		}
+		result = append(result, best.toString())
+	}
+	return result, nil
+}
+
+// nodesWithinHops - возвращает вершины (включая start), находящиеся не далее k ребер от start
+func (g *Graph) nodesWithinHops(start *Node, k int) []*Node {
+	distances := map[*Node]int{start: 0}
+	result := []*Node{start}
+	for i := 0; i < len(result); i++ {
+		current := result[i]
+		if distances[current] == k {
+			continue
+		}
+		for next := range g.edges[current] {
+			if _, ok := distances[next]; !ok {
+				distances[next] = distances[current] + 1
+				result = append(result, next)
+			}
+		}
+	}
+	return result
+}
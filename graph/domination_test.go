@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// starGraph - возвращает неориентированную звезду с центром "c" и листьями leaves
+func starGraph(t testing.TB, leaves ...string) *Graph {
+	edges := make([][3]string, len(leaves))
+	for i, leaf := range leaves {
+		edges[i] = [3]string{"c", leaf}
+	}
+	return mustGraph(t, false, false, edges)
+}
+
+// dominates - проверяет, что каждая вершина графа находится не далее k ребер от одной из вершин set
+func dominates(g *Graph, set []string, k int) bool {
+	dominated := map[*Node]bool{}
+	for _, value := range set {
+		for _, node := range g.nodesWithinHops(g.getRefOfNode(value), k) {
+			dominated[node] = true
+		}
+	}
+	return len(dominated) == len(g.edges)
+}
+
+func TestGreedyDominatingSet(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *Graph
+		want []string
+	}{
+		{"звезда", starGraph(t, "a", "b", "d", "e"), []string{"c"}},
+		{"путь из 5 вершин", NewPathGraph(5), []string{"1", "3"}},
+		{"путь из 7 вершин", NewPathGraph(7), []string{"1", "4", "5"}},
+		{"изолированные вершины", NewPathGraph(1), []string{"0"}},
+		{"пустой граф", NewEmptyGraph(), []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.g.GreedyDominatingSet()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GreedyDominatingSet() = %v, want %v", got, tt.want)
+			}
+			if !dominates(tt.g, got, 1) {
+				t.Errorf("GreedyDominatingSet() = %v не доминирует граф", got)
+			}
+		})
+	}
+}
+
+func TestDistanceKDominatingSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *Graph
+		k       int
+		want    []string
+		wantErr bool
+	}{
+		{"путь, k = 1", NewPathGraph(9), 1, []string{"1", "4", "7"}, false},
+		{"путь, k = 2", NewPathGraph(9), 2, []string{"2", "6"}, false},
+		{"путь, k = 4", NewPathGraph(9), 4, []string{"4"}, false},
+		{"орграф по направлению дуг", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "d"}}), 2, []string{"a", "b"}, false},
+		{"k = 0", NewPathGraph(3), 0, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.DistanceKDominatingSet(tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DistanceKDominatingSet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DistanceKDominatingSet() = %v, want %v", got, tt.want)
+			}
+			if err == nil && !dominates(tt.g, got, tt.k) {
+				t.Errorf("DistanceKDominatingSet() = %v не покрывает граф", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"errors"
+	"math"
+)
+
+/*
+
+Эйлеровы графы:
+- EdgesToMakeEulerian - минимальное дополнение графа до эйлерова
+
+*/
+
+// maxOddNodesForMatching - наибольшее количество вершин нечетной степени,
+// для которого выполняется точный поиск паросочетания (перебор подмножеств)
+const maxOddNodesForMatching = 20
+
+// EdgesToMakeEulerian - для связного неориентированного графа находит, какие ребра нужно добавить,
+// чтобы в графе существовал эйлеров цикл. Вершины нечетной степени (в мультиграфе с учетом параллельных ребер)
+// разбиваются на пары паросочетанием минимального веса по кратчайшим расстояниям (как в задаче китайского почтальона).
+// Возвращает количество ребер (половина числа нечетных вершин) и сами ребра с весом,
+// равным кратчайшему расстоянию между их концами
+func (g *Graph) EdgesToMakeEulerian() (int, []Edge, error) {
+	if g.is_oriented {
+		return 0, nil, errors.New("Граф должен быть неориентированным")
+	}
+	if g.HasNegativeEdge() {
+		return 0, nil, errors.New("Граф не должен содержать ребер отрицательного веса")
+	}
+	values := g.nodeValues()
+	if len(values) > 0 && len(g.Bfs(values[0], false)) != len(values) {
+		return 0, nil, errors.New("Граф является несвязным!")
+	}
+
+	// Вершины нечетной степени с учетом кратных ребер мультиграфа, петли на четность не влияют
+	counts := g.linkCounts()
+	odd := []*Node{}
+	for _, value := range values {
+		node := g.getRefOfNode(value)
+		degree := 0
+		for _, n := range counts[node] {
+			degree += n
+		}
+		if degree%2 != 0 {
+			odd = append(odd, node)
+		}
+	}
+	if len(odd) > maxOddNodesForMatching {
+		return 0, nil, errors.New("Слишком много вершин нечетной степени для точного поиска")
+	}
+
+	// Кратчайшие расстояния между нечетными вершинами
+	distances := make([][]int, len(odd))
+	for i, node := range odd {
+		d, _ := g.dijkstra(node)
+		distances[i] = make([]int, len(odd))
+		for j, other := range odd {
+			distances[i][j] = d[other]
+		}
+	}
+
+	// Паросочетание минимального веса: dp[mask] - лучший вес для уже разбитых на пары вершин mask,
+	// первая свободная вершина всегда берется в пару первой
+	full := 1<<len(odd) - 1
+	dp := make([]int, full+1)
+	choice := make([]int, full+1)
+	for mask := 1; mask <= full; mask++ {
+		dp[mask] = math.MaxInt
+	}
+	for mask := 0; mask < full; mask++ {
+		if dp[mask] == math.MaxInt {
+			continue
+		}
+		i := 0
+		for mask&(1<<i) != 0 {
+			i++
+		}
+		for j := i + 1; j < len(odd); j++ {
+			if mask&(1<<j) != 0 {
+				continue
+			}
+			next := mask | 1<<i | 1<<j
+			if dp[mask]+distances[i][j] < dp[next] {
+				dp[next] = dp[mask] + distances[i][j]
+				choice[next] = i*len(odd) + j
+			}
+		}
+	}
+
+	result := []Edge{}
+	for mask := full; mask != 0; {
+		i, j := choice[mask]/len(odd), choice[mask]%len(odd)
+		result = append(result, Edge{odd[i].toString(), odd[j].toString(), distances[i][j]})
+		mask &^= 1<<i | 1<<j
+	}
+	return len(result), result, nil
+}
@@ -0,0 +1,54 @@
+package graph
+
+import "testing"
+
+func TestEdgesToMakeEulerian(t *testing.T) {
+	tests := []struct {
+		name       string
+		multigraph bool
+		edges      []Edge
+		want       int
+		wantWeight int
+		wantErr    bool
+	}{
+		{"цикл уже эйлеров", false, []Edge{{"a", "b", 1}, {"b", "c", 1}, {"c", "a", 1}}, 0, 0, false},
+		{"путь", false, []Edge{{"a", "b", 2}, {"b", "c", 3}}, 1, 5, false},
+		{"петля не меняет четность", false, []Edge{{"a", "b", 1}, {"b", "b", 1}}, 1, 1, false},
+		{"два параллельных ребра", true, []Edge{{"a", "b", 1}, {"a", "b", 1}}, 0, 0, false},
+		{"три параллельных ребра", true, []Edge{{"a", "b", 1}, {"a", "b", 4}, {"a", "b", 2}}, 1, 1, false},
+		{"несвязный граф", false, []Edge{{"a", "b", 1}, {"c", "d", 1}}, 0, 0, true},
+		{"отрицательный вес", false, []Edge{{"a", "b", -1}}, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewEmptyGraph()
+			if tt.multigraph {
+				g = NewEmptyMultigraph()
+			}
+			g.is_oriented = false
+			g.AddEdges(tt.edges)
+			count, edges, err := g.EdgesToMakeEulerian()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EdgesToMakeEulerian() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if count != tt.want || len(edges) != tt.want {
+				t.Errorf("EdgesToMakeEulerian() = %d, %v, want %d ребер", count, edges, tt.want)
+			}
+			weight := 0
+			for _, e := range edges {
+				weight += e.Weight
+			}
+			if weight != tt.wantWeight {
+				t.Errorf("суммарный вес = %d, want %d", weight, tt.wantWeight)
+			}
+		})
+	}
+}
+
+func TestEdgesToMakeEulerianOriented(t *testing.T) {
+	g := NewEmptyGraph()
+	g.AddEdge("a", "b", 1)
+	if _, _, err := g.EdgesToMakeEulerian(); err == nil {
+		t.Error("EdgesToMakeEulerian() для орграфа должен вернуть ошибку")
+	}
+}
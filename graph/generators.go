@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+/*
+
+Генераторы графов:
+- NewRandomGraph - случайный граф G(n, p) (модель Эрдёша-Реньи)
+- NewRandomWeightedGraph - случайный взвешенный граф G(n, p) с весами от 1 до maxWeight
+- NewGridGraph - решетка rows x cols
+- NewCycleGraph - простой цикл на n вершинах
+- NewPathGraph - простой путь на n вершинах
+
+*/
+
+// defaultMaxRandomWeight - наибольший вес связи случайного взвешенного графа, созданного NewRandomGraph
+const defaultMaxRandomWeight = 100
+
+// NewRandomGraph - создает случайный граф G(n, p) на вершинах "0", ..., "n-1": каждая пара различных вершин
+// (для орграфа - каждая упорядоченная пара) соединяется независимо с вероятностью p.
+// Веса взвешенного графа выбираются равномерно от 1 до 100. Генерация зависит только от состояния rng,
+// поэтому генератор с фиксированным зерном дает один и тот же граф; если rng равен nil,
+// используется генератор, инициализированный текущим временем
+func NewRandomGraph(n int, p float64, oriented, weighted bool, rng *rand.Rand) *Graph {
+	maxWeight := 0
+	if weighted {
+		maxWeight = defaultMaxRandomWeight
+	}
+	return newRandomGraph(n, p, oriented, maxWeight, rng)
+}
+
+// NewRandomWeightedGraph - создает случайный взвешенный граф G(n, p) (см. NewRandomGraph),
+// веса связей выбираются равномерно от 1 до maxWeight (при maxWeight меньше 1 все веса равны 1)
+func NewRandomWeightedGraph(n, maxWeight int, p float64, oriented bool, rng *rand.Rand) *Graph {
+	if maxWeight < 1 {
+		maxWeight = 1
+	}
+	return newRandomGraph(n, p, oriented, maxWeight, rng)
+}
+
+// newRandomGraph - создает случайный граф G(n, p), при maxWeight равном 0 - невзвешенный.
+// Пары вершин перебираются в фиксированном порядке, чтобы результат определялся только rng
+func newRandomGraph(n int, p float64, oriented bool, maxWeight int, rng *rand.Rand) *Graph {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	g := NewEmptyGraph()
+	g.is_oriented = oriented
+	g.is_suspended = maxWeight > 0
+	names := make([]string, n)
+	for i := range names {
+		names[i] = strconv.Itoa(i)
+		g.addNode(names[i])
+	}
+	for i := 0; i < n; i++ {
+		j := i + 1
+		if oriented {
+			j = 0
+		}
+		for ; j < n; j++ {
+			if i == j || rng.Float64() >= p {
+				continue
+			}
+			distance := -1
+			if maxWeight > 0 {
+				distance = 1 + rng.Intn(maxWeight)
+			}
+			g.AddEdge(names[i], names[j], distance)
+		}
+	}
+	return g
+}
+
+// newUnweightedUndirectedGraph - создает пустой неориентированный невзвешенный граф
+func newUnweightedUndirectedGraph() *Graph {
+	g := NewEmptyGraph()
+	g.is_oriented = false
+	g.is_suspended = false
+	return g
+}
+
+// NewGridGraph - создает неориентированную невзвешенную решетку из rows строк и cols столбцов.
+// Вершина в строке r и столбце c называется "r,c" (нумерация с нуля) и соединена с соседями справа и снизу.
+// При неположительных размерах возвращает пустой граф
+func NewGridGraph(rows, cols int) *Graph {
+	g := newUnweightedUndirectedGraph()
+	name := func(r, c int) string {
+		return strconv.Itoa(r) + "," + strconv.Itoa(c)
+	}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			g.addNode(name(r, c))
+		}
+	}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c+1 < cols {
+				g.AddEdge(name(r, c), name(r, c+1), -1)
+			}
+			if r+1 < rows {
+				g.AddEdge(name(r, c), name(r+1, c), -1)
+			}
+		}
+	}
+	return g
+}
+
+// NewPathGraph - создает неориентированный невзвешенный путь "0" - "1" - ... - "n-1".
+// При неположительном n возвращает пустой граф
+func NewPathGraph(n int) *Graph {
+	g := newUnweightedUndirectedGraph()
+	for i := 0; i < n; i++ {
+		g.addNode(strconv.Itoa(i))
+		if i > 0 {
+			g.AddEdge(strconv.Itoa(i-1), strconv.Itoa(i), -1)
+		}
+	}
+	return g
+}
+
+// NewCycleGraph - создает неориентированный невзвешенный цикл "0" - "1" - ... - "n-1" - "0".
+// Цикл существует при n не меньше 3, при меньших n возвращается путь на n вершинах (см. NewPathGraph)
+func NewCycleGraph(n int) *Graph {
+	g := NewPathGraph(n)
+	if n >= 3 {
+		g.AddEdge(strconv.Itoa(n-1), "0", -1)
+	}
+	return g
+}
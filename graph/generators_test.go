@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestNewRandomGraph(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		p         float64
+		oriented  bool
+		maxWeight int // 0 - NewRandomGraph, иначе NewRandomWeightedGraph
+		weighted  bool
+		wantEdges int // -1 - количество связей случайно
+	}{
+		{"p = 0 - нет связей", 6, 0, false, 0, false, 0},
+		{"p = 1 - полный граф", 6, 1, false, 0, false, 15},
+		{"p = 1 - полный орграф", 6, 1, true, 0, true, 30},
+		{"невзвешенный граф", 20, 0.3, false, 0, false, -1},
+		{"взвешенный орграф", 20, 0.3, true, 0, true, -1},
+		{"веса до maxWeight", 20, 0.5, false, 3, true, -1},
+		{"maxWeight меньше 1", 8, 1, true, -5, true, 56},
+		{"пустой граф", 0, 0.5, false, 0, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			build := func(seed int64) *Graph {
+				rng := rand.New(rand.NewSource(seed))
+				if tt.maxWeight != 0 {
+					return NewRandomWeightedGraph(tt.n, tt.maxWeight, tt.p, tt.oriented, rng)
+				}
+				return NewRandomGraph(tt.n, tt.p, tt.oriented, tt.weighted, rng)
+			}
+			g := build(42)
+			if !g.Equal(build(42)) {
+				t.Error("одно и то же зерно дало разные графы")
+			}
+			if g.IsOriented() != tt.oriented || g.IsWeighted() != tt.weighted {
+				t.Errorf("IsOriented(), IsWeighted() = %v, %v, want %v, %v", g.IsOriented(), g.IsWeighted(), tt.oriented, tt.weighted)
+			}
+			if len(g.nodeValues()) != tt.n {
+				t.Errorf("количество вершин = %d, want %d", len(g.nodeValues()), tt.n)
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+			edges := g.Edges()
+			if tt.wantEdges >= 0 && len(edges) != tt.wantEdges {
+				t.Errorf("количество связей = %d, want %d", len(edges), tt.wantEdges)
+			}
+			maxWeight := tt.maxWeight
+			if maxWeight == 0 {
+				maxWeight = defaultMaxRandomWeight
+			} else if maxWeight < 1 {
+				maxWeight = 1
+			}
+			for _, e := range edges {
+				if e.From == e.To {
+					t.Errorf("петля %v", e)
+				}
+				if !tt.weighted && e.Weight != -1 {
+					t.Errorf("вес связи %v в невзвешенном графе", e)
+				}
+				if tt.weighted && (e.Weight < 1 || e.Weight > maxWeight) {
+					t.Errorf("вес связи %v вне [1, %d]", e, maxWeight)
+				}
+			}
+		})
+	}
+}
+
+func TestNewGridCyclePathGraph(t *testing.T) {
+	tests := []struct {
+		name       string
+		g          *Graph
+		wantNodes  int
+		wantEdges  int
+		from, to   string
+		wantLength int
+	}{
+		{"решетка 3 x 4", NewGridGraph(3, 4), 12, 17, "0,0", "2,3", 5},
+		{"решетка 1 x 5", NewGridGraph(1, 5), 5, 4, "0,0", "0,4", 4},
+		{"пустая решетка", NewGridGraph(0, 3), 0, 0, "", "", 0},
+		{"цикл на 6 вершинах", NewCycleGraph(6), 6, 6, "0", "4", 2},
+		{"цикл на 2 вершинах - путь", NewCycleGraph(2), 2, 1, "0", "1", 1},
+		{"путь на 5 вершинах", NewPathGraph(5), 5, 4, "0", "4", 4},
+		{"путь на 1 вершине", NewPathGraph(1), 1, 0, "0", "0", 0},
+		{"пустой путь", NewPathGraph(-1), 0, 0, "", "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.g.IsOriented() || tt.g.IsWeighted() {
+				t.Error("граф должен быть неориентированным и невзвешенным")
+			}
+			if got := len(tt.g.nodeValues()); got != tt.wantNodes {
+				t.Errorf("количество вершин = %d, want %d", got, tt.wantNodes)
+			}
+			if got := len(tt.g.Edges()); got != tt.wantEdges {
+				t.Errorf("количество ребер = %d, want %d", got, tt.wantEdges)
+			}
+			if tt.from == "" {
+				return
+			}
+			if _, length, err := tt.g.shortestPath(tt.from, tt.to); err != nil || length != tt.wantLength {
+				t.Errorf("shortestPath(%s, %s) = %d, %v, want %d", tt.from, tt.to, length, err, tt.wantLength)
+			}
+		})
+	}
+	// Вершина "r,c" решетки соединена с соседями по строке и столбцу
+	grid := NewGridGraph(3, 4)
+	if got := grid.BFSLevels("1,1"); got["0,1"] != 1 || got["1,0"] != 1 || got["1,2"] != 1 || got["2,1"] != 1 || got["0,0"] != 2 {
+		t.Errorf("соседи вершины 1,1 = %v", got)
+	}
+	if grid.HasNode("3,0") || grid.HasNode("0,4") {
+		t.Error("вершина вне решетки")
+	}
+}
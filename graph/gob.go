@@ -0,0 +1,30 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+/*
+
+Двоичная сериализация графа (gob):
+- GobEncode - кодирует граф
+- GobDecode - восстанавливает граф
+
+*/
+
+// GobEncode - кодирует граф, включая флаги и изолированные узлы
+func (g *Graph) GobEncode() ([]byte, error) {
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(g.snapshot())
+	return buffer.Bytes(), err
+}
+
+// GobDecode - восстанавливает граф, полностью заменяя текущие узлы и связи
+func (g *Graph) GobDecode(data []byte) error {
+	var raw graphData
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&raw); err != nil {
+		return err
+	}
+	return g.restore(raw)
+}
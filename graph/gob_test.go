@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+// serializationCases - графы для проверки сохранения и восстановления
+func serializationCases(t *testing.T) []struct {
+	name string
+	g    *Graph
+} {
+	isolated := mustGraph(t, true, true, [][3]string{{"a", "b", "3"}, {"b", "c", "2"}})
+	isolated.AddNode("z")
+	isolated.AddNode("y")
+
+	undirected := mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "b"}, {"c", "a"}})
+	undirected.SetNodeAttr("a", "color", "red")
+
+	multigraph := NewEmptyMultigraph()
+	multigraph.AddEdge("a", "b", 1)
+	multigraph.AddEdge("a", "b", 5)
+	multigraph.AddEdge("b", "a", 2)
+
+	noLoops := mustGraph(t, true, true, [][3]string{{"a", "b", "1"}})
+	noLoops.AllowSelfLoops(false)
+
+	return []struct {
+		name string
+		g    *Graph
+	}{
+		{"изолированные вершины", isolated},
+		{"неориентированный с петлей и атрибутом", undirected},
+		{"мультиграф", multigraph},
+		{"запрет петель", noLoops},
+		{"пустой граф", NewEmptyGraph()},
+	}
+}
+
+// checkRestored - проверяет, что восстановленный граф совпадает с исходным
+func checkRestored(t *testing.T, g, restored *Graph) {
+	t.Helper()
+	if !g.Equal(restored) {
+		t.Errorf("восстановленный граф %v, want %v", restored.Edges(), g.Edges())
+	}
+	if restored.no_self_loops != g.no_self_loops {
+		t.Errorf("no_self_loops = %v, want %v", restored.no_self_loops, g.no_self_loops)
+	}
+	for _, value := range g.nodeValues() {
+		if !restored.HasNode(value) {
+			t.Errorf("вершина %s потеряна", value)
+		}
+		if want, ok := g.NodeAttr(value, "color"); ok {
+			if got, _ := restored.NodeAttr(value, "color"); got != want {
+				t.Errorf("атрибут %s = %q, want %q", value, got, want)
+			}
+		}
+	}
+	if errs := restored.Validate(); len(errs) != 0 {
+		t.Errorf("Validate() = %v", errs)
+	}
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	for _, tt := range serializationCases(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			if err := gob.NewEncoder(&buffer).Encode(tt.g); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			restored := NewEmptyGraph()
+			if err := gob.NewDecoder(&buffer).Decode(restored); err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			checkRestored(t, tt.g, restored)
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, tt := range serializationCases(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.g)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			restored := NewEmptyGraph()
+			if err := json.Unmarshal(data, restored); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			checkRestored(t, tt.g, restored)
+		})
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"некорректный JSON", `{"oriented":`},
+		{"петля при запрете петель", `{"oriented":true,"suspended":true,"no_self_loops":true,"nodes":["a"],"edges":[{"from":"a","to":"a","weight":1}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.data), NewEmptyGraph()); err == nil {
+				t.Error("Unmarshal() должен вернуть ошибку")
+			}
+		})
+	}
+}
@@ -0,0 +1,1910 @@
+// Package graph - реализация графа (ориентированного / неориентированного, взвешенного / невзвешенного)
+// и алгоритмов на нем
+package graph
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cheekybits/genny/generic"
+)
+
+// Generic для описания узла
+type Item generic.Type
+
+type Node struct {
+	value Item
+}
+
+// toString - функция для строчного представления узла
+func (n *Node) toString() string {
+	return fmt.Sprintf("%v", n.value)
+}
+
+/*
+Graph - конструктор, создающий пустой граф:
+- mutex - блокировка структуры
+- is_oriented - ориентированный ли граф
+- is_suspended - взвешенный ли граф
+- edges - ребра / дуги графа
+- is_multigraph - допускаются ли кратные (параллельные) связи
+- parallel - веса всех кратных связей между парой узлов (только для мультиграфа),
+в edges для мультиграфа хранится минимальный из них
+- attributes - дополнительные атрибуты узлов (ключ - значение)
+- no_self_loops - запрещены ли петли (по умолчанию петли разрешены)
+- in_degree - количество входящих дуг каждого узла, поддерживается при изменении связей
+(количество исходящих равно длине списка смежности)
+- output - поток, в который методы Print... и алгоритмы с выводом пишут результат (по умолчанию os.Stdout)
+- index - узлы по значениям, поддерживается при добавлении, переименовании и удалении узлов,
+поэтому поиск узла по значению выполняется за O(1)
+
+Петли (связь узла с самим собой) обрабатываются так:
+- степени (InDegree, OutDegree, Degree) учитывают петлю один раз;
+- Floyd и другие алгоритмы поиска кратчайших путей считают расстояние от вершины до самой себя равным 0;
+- обходы (BFSOrder, DFSOrder) петли пропускают;
+- IsGraphTreeOrForest и topologicalOrder считают петлю циклом
+
+Связи отрицательного веса (см. HasNegativeEdge):
+- допускают Floyd, BellmanFord, ShortestPathBF, Bellman и ShortestPathAvoiding (переходит на Беллмана-Форда);
+- Deikstra, RadiusCtx, GetRadiusOfGraph и EdgesToMakeEulerian возвращают ошибку;
+- AllPairsShortestPaths возвращает nil
+*/
+type Graph struct {
+	mutex         sync.Mutex
+	is_oriented   bool
+	is_suspended  bool
+	edges         map[*Node]map[*Node]int
+	is_multigraph bool
+	parallel      map[*Node]map[*Node][]int
+	attributes    map[*Node]map[string]string
+	no_self_loops bool
+	output        io.Writer
+	in_degree     map[*Node]int
+	index         map[string]*Node
+}
+
+// Edge - описание дуги / ребра через значения узлов
+type Edge struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Weight int    `json:"weight"`
+}
+
+/*
+
+Конструкторы:
+- NewEmptyGraph - конструктор, возвращающий пустой, неориентированный, взвешенный граф
+- NewEmptyMultigraph - конструктор, возвращающий пустой мультиграф
+- NewCopiedGraph - функция для глубокого копирования графа, возвращает ссылку на свою полную копию
+- NewGraphFromFile - возвращает граф, созданный из данный файла
+- NewGraphFromReader, NewGraphFromReaderStrict - возвращают граф, созданный из данных в файловом формате
+- NewCompleteGraph - создает полный граф на заданных вершинах
+- NewGraphFromEdges - создает граф по списку связей
+*/
+
+// NewEmptyGraph - конструктор, возвращающий пустой, ориентированный, взвешенный граф
+func NewEmptyGraph() *Graph {
+	return &Graph{sync.Mutex{}, true, true, make(map[*Node]map[*Node]int), false, nil, nil, false, nil, make(map[*Node]int), make(map[string]*Node)}
+}
+
+// NewEmptyMultigraph - конструктор, возвращающий пустой, ориентированный, взвешенный мультиграф:
+// повторное добавление связи между теми же узлами создает параллельную связь вместо перезаписи.
+// Алгоритмы кратчайших путей используют минимальный вес параллельных связей,
+// алгоритмы потоков - сумму их пропускных способностей
+func NewEmptyMultigraph() *Graph {
+	g := NewEmptyGraph()
+	g.is_multigraph = true
+	g.parallel = make(map[*Node]map[*Node][]int)
+	return g
+}
+
+// NewCopiedGraph - функция для глубокого копирования графа, возвращает ссылку на свою полную копию
+func NewCopiedGraph(g *Graph) *Graph {
+	newGraph := NewEmptyGraph()
+	newGraph.is_oriented = g.is_oriented
+	newGraph.is_suspended = g.is_suspended
+	newGraph.edges = make(map[*Node]map[*Node]int, len(g.edges))
+	if g.is_multigraph {
+		newGraph.is_multigraph = true
+		newGraph.parallel = make(map[*Node]map[*Node][]int)
+	}
+	// Сначала все вершины, чтобы в копию попали и изолированные
+	for k1 := range g.edges {
+		newGraph.addNode(k1.toString())
+	}
+	for k1, v1 := range g.edges {
+		for k2 := range v1 {
+			for _, w := range g.weightsBetween(k1, k2) {
+				newGraph.AddDirectedEdge(k1.toString(), k2.toString(), w)
+			}
+		}
+	}
+	for node, values := range g.attributes {
+		for key, value := range values {
+			newGraph.SetNodeAttr(node.toString(), key, value)
+		}
+	}
+	newGraph.no_self_loops = g.no_self_loops
+	newGraph.output = g.output
+	return newGraph
+}
+
+// NewGraphFromFile - возвращает граф, созданный из данных файла.
+// Если при выполнении возникает ошибка, то возвращает ее и пустой граф
+func NewGraphFromFile(path string) (*Graph, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return NewEmptyGraph(), err // Пустой граф и ошибка
+	}
+	defer file.Close()
+	g, err := NewGraphFromReader(file)
+	if err != nil {
+		return g, fmt.Errorf("%s: %w", path, err) // Ошибка с указанием файла
+	}
+	return g, nil
+}
+
+// NewGraphFromReader - возвращает граф, созданный из данных в файловом формате, прочитанных из r.
+// Пустые строки и лишние пробелы между значениями пропускаются, повторная строка с той же связью
+// перезаписывает ее вес. Исключение - неориентированный взвешенный граф: если ребро записано дважды
+// (в том числе как "a b" и "b a") с разными весами, возвращается ErrConflictingEdge с номерами обеих строк.
+// Если при выполнении возникает ошибка, то возвращает ее и пустой граф
+func NewGraphFromReader(r io.Reader) (*Graph, error) {
+	return readGraph(r, false)
+}
+
+// NewGraphFromReaderStrict - то же, что NewGraphFromReader, но повторная строка с уже добавленной связью
+// приводит к ошибке ErrDuplicateEdge с номером строки
+func NewGraphFromReaderStrict(r io.Reader) (*Graph, error) {
+	return readGraph(r, true)
+}
+
+// ErrConflictingEdge - ошибка загрузки неориентированного графа: ребро записано несколько раз с разными весами
+var ErrConflictingEdge = errors.New("Ребро записано несколько раз с разными весами")
+
+// readGraph - читает граф в файловом формате, rejectDuplicates - запрет повторных связей.
+// Данные читаются построчно и сразу добавляются в граф, поэтому память пропорциональна размеру графа,
+// а не файла: заголовок проверяется по первым двум непустым строкам, каждая следующая строка - связь.
+// Узлы находятся через индекс значений, поэтому каждая строка обрабатывается за O(1)
+func readGraph(r io.Reader, rejectDuplicates bool) (*Graph, error) {
+	g := NewEmptyGraph()
+	scanner := bufio.NewScanner(r)
+	header := make([]string, 0, 2)
+
+	// Номера строк, в которых впервые записаны ребра неориентированного графа
+	firstLines := make(map[[2]string]int)
+
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if len(header) < 2 {
+			header = append(header, line)
+			if len(header) < 2 {
+				continue
+			}
+			if err := validateData(header); err != nil {
+				return NewEmptyGraph(), err
+			}
+			// Ориентированность и взвешенность
+			g.is_oriented = header[0] != "unoriented"
+			g.is_suspended = header[1] != "unsuspended"
+			continue
+		}
+		// Заполнение узлов и дуг / ребер
+		if err := g.readEdgeLine(line, number, firstLines, rejectDuplicates); err != nil {
+			return NewEmptyGraph(), err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return NewEmptyGraph(), err
+	}
+	if err := validateData(header); err != nil {
+		return NewEmptyGraph(), err // Заголовок неполон
+	}
+	return g, nil
+}
+
+// readEdgeLine - добавляет в граф связь, записанную в строке line с номером number.
+// firstLines хранит номера строк, в которых впервые записаны ребра неориентированного графа
+func (g *Graph) readEdgeLine(line string, number int, firstLines map[[2]string]int, rejectDuplicates bool) error {
+	currentData := strings.Fields(line)
+	if len(currentData) != 3 {
+		return fmt.Errorf("Строка %d: ожидается 3 значения (узел 1, узел 2, расстояние), получено %d", number, len(currentData))
+	}
+	currentDistance, err := strconv.Atoi(currentData[2])
+	if err != nil {
+		return fmt.Errorf("Строка %d: %w", number, err) // Ошибка преобразования числа
+	}
+	if !g.is_suspended {
+		currentDistance = -1
+	}
+	// Узлы находятся и создаются через индекс значений, связь записывается напрямую, без повторных поисков
+	ref1, ref2 := g.addNode(currentData[0]), g.addNode(currentData[1])
+	old, exists := g.edges[ref1][ref2]
+	if !g.is_oriented && g.is_suspended {
+		key := [2]string{currentData[0], currentData[1]}
+		if key[1] < key[0] {
+			key[0], key[1] = key[1], key[0]
+		}
+		if exists && old != currentDistance {
+			return fmt.Errorf("Строки %d и %d: %s - %s: %w", firstLines[key], number, key[0], key[1], ErrConflictingEdge)
+		}
+		if _, ok := firstLines[key]; !ok {
+			firstLines[key] = number
+		}
+	}
+	if exists && rejectDuplicates {
+		return fmt.Errorf("Строка %d: %w", number, ErrDuplicateEdge)
+	}
+	g.setEdge(ref1, ref2, currentDistance)
+	if !g.is_oriented && ref1 != ref2 {
+		g.setEdge(ref2, ref1, currentDistance)
+	}
+	return nil
+}
+
+// NewGraphFromEdges - создает граф по списку связей, каждая связь задается тройкой (узел 1, узел 2, вес).
+// Для невзвешенного графа вес не проверяется и может быть пустым.
+// Если название узла пустое или вес не является числом, то возвращает ошибку с номером связи
+func NewGraphFromEdges(oriented, weighted bool, edges [][3]string) (*Graph, error) {
+	g := NewEmptyGraph()
+	g.is_oriented = oriented
+	g.is_suspended = weighted
+	for i, e := range edges {
+		if e[0] == "" || e[1] == "" {
+			return nil, fmt.Errorf("Связь %d: пустое название узла", i+1)
+		}
+		distance := -1
+		if weighted {
+			d, err := strconv.Atoi(e[2])
+			if err != nil {
+				return nil, fmt.Errorf("Связь %d: %w", i+1, err)
+			}
+			distance = d
+		}
+		g.AddEdge(e[0], e[1], distance)
+	}
+	return g, nil
+}
+
+// NewCompleteGraph - создает полный граф на вершинах names.
+// Граф является неориентированный, невзвешенным и не содержит петель
+func NewCompleteGraph(names []string) *Graph {
+	g := NewEmptyGraph()
+	g.is_suspended = false
+	g.is_oriented = false
+	for _, name := range names {
+		g.addNode(name)
+	}
+	// Каждая пара различных вершин соединяется один раз
+	for i := 0; i < len(names); i++ {
+		for j := i + 1; j < len(names); j++ {
+			if names[i] == names[j] {
+				continue
+			}
+			g.AddEdge(names[i], names[j], 0)
+		}
+	}
+	return g
+}
+
+/*
+
+Методы:
+- addNode - добавляет вершину в граф
+- AddNode - добавляет вершину в граф и сообщает, была ли она создана
+- RenameNode - меняет значение вершины
+- AddEdge - добавляет дугу / ребро между узлами
+- AddEdges - добавляет набор связей
+- AddEdgeIfAbsent - добавляет дугу / ребро, если связи еще нет
+- AddDirectedEdge - добавляет одну дугу независимо от ориентированности графа
+- SetWeight - заменяет вес существующей дуги / ребра
+- AllowSelfLoops, HasSelfLoop - задают политику петель и проверяют наличие петли
+- SelfLoops - возвращает вершины с петлями
+- SetNodeAttr, NodeAttr - задают и возвращают атрибуты узла
+- RemoveEdge - удаляет дугу / ребро и сообщает, было ли что-то удалено
+- removeEdge - удаляет дугу / ребро
+- RemoveNode - удаляет узел и все входящие и исходящие ребра / дуги
+- Clear, RemoveAllEdges - удаляют все вершины и связи или только связи
+- PrintDataInFile - выводит данные о графе в файл
+
+*/
+
+// addNode - добавляет вершину в граф
+func (g *Graph) addNode(value string) *Node {
+	ref := g.getRefOfNode(value)
+	if ref == nil {
+		node := &Node{value}
+		g.edges[node] = map[*Node]int{}
+		if g.index == nil {
+			g.index = make(map[string]*Node)
+		}
+		g.index[value] = node
+		return node
+	} else {
+		return ref
+	}
+}
+
+// AddNode - добавляет вершину в граф и возвращает true, если она была создана,
+// и false, если такая вершина уже существовала
+func (g *Graph) AddNode(value string) bool {
+	if g.getRefOfNode(value) != nil {
+		return false
+	}
+	g.addNode(value)
+	return true
+}
+
+// RenameNode - меняет значение вершины oldValue на newValue, сохраняя все ее связи и атрибуты.
+// Связи хранятся по ссылкам на узлы, поэтому достаточно изменить значение самого узла.
+// Если вершины oldValue нет или вершина newValue уже существует, то возвращает ошибку
+func (g *Graph) RenameNode(oldValue, newValue string) error {
+	if err := validateNode(g, oldValue); err != nil {
+		return err
+	}
+	if oldValue == newValue {
+		return nil
+	}
+	if g.getRefOfNode(newValue) != nil {
+		return errors.New("Вершина " + newValue + " уже существует в графе!")
+	}
+	node := g.getRefOfNode(oldValue)
+	node.value = newValue
+	delete(g.index, oldValue)
+	g.index[newValue] = node
+	return nil
+}
+
+// AddEdge - добавляет дугу / ребро между узлами,
+// если соединить два узла, между которыми уже есть связь, то перезапишет ее
+// (в мультиграфе добавит параллельную связь).
+// Если узла нет, то создаст его. Если петли запрещены, то для value1 == value2 возвращает ErrSelfLoop
+func (g *Graph) AddEdge(value1, value2 string, distance int) error {
+	if g.no_self_loops && value1 == value2 {
+		return ErrSelfLoop
+	}
+	ref1 := g.addNode(value1)
+	ref2 := g.addNode(value2)
+	if !g.is_suspended {
+		distance = -1
+	}
+	g.setEdge(ref1, ref2, distance)
+	if !g.is_oriented && ref1 != ref2 {
+		g.setEdge(ref2, ref1, distance)
+	}
+	return nil
+}
+
+// AddEdges - добавляет связи edges так же, как последовательные вызовы AddEdge:
+// политика петель проверяется один раз до добавления, узлы находятся и создаются через индекс значений графа.
+// Если петли запрещены и среди связей есть петля, то ничего не добавляет и возвращает ErrSelfLoop с номером связи
+func (g *Graph) AddEdges(edges []Edge) error {
+	if g.no_self_loops {
+		for i, e := range edges {
+			if e.From == e.To {
+				return fmt.Errorf("Связь %d: %w", i+1, ErrSelfLoop)
+			}
+		}
+	}
+	for _, e := range edges {
+		ref1, ref2 := g.addNode(e.From), g.addNode(e.To)
+		distance := e.Weight
+		if !g.is_suspended {
+			distance = -1
+		}
+		g.setEdge(ref1, ref2, distance)
+		if !g.is_oriented && ref1 != ref2 {
+			g.setEdge(ref2, ref1, distance)
+		}
+	}
+	return nil
+}
+
+// setEdge - записывает дугу из ref1 в ref2: перезаписывает вес,
+// а в мультиграфе добавляет параллельную дугу и хранит в edges минимальный вес
+func (g *Graph) setEdge(ref1, ref2 *Node, distance int) {
+	if _, ok := g.edges[ref1][ref2]; !ok {
+		g.in_degree[ref2]++
+	}
+	if !g.is_multigraph {
+		g.edges[ref1][ref2] = distance
+		return
+	}
+	if g.parallel[ref1] == nil {
+		g.parallel[ref1] = map[*Node][]int{}
+	}
+	g.parallel[ref1][ref2] = append(g.parallel[ref1][ref2], distance)
+	if old, ok := g.edges[ref1][ref2]; !ok || distance < old {
+		g.edges[ref1][ref2] = distance
+	}
+}
+
+// deleteEdge - удаляет дугу из ref1 в ref2 вместе со всеми параллельными
+func (g *Graph) deleteEdge(ref1, ref2 *Node) {
+	if _, ok := g.edges[ref1][ref2]; ok {
+		g.in_degree[ref2]--
+	}
+	delete(g.edges[ref1], ref2)
+	if g.parallel != nil {
+		delete(g.parallel[ref1], ref2)
+	}
+}
+
+// weightsBetween - возвращает веса всех дуг из ref1 в ref2 (для обычного графа не более одной)
+func (g *Graph) weightsBetween(ref1, ref2 *Node) []int {
+	if g.is_multigraph {
+		return g.parallel[ref1][ref2]
+	}
+	if w, ok := g.edges[ref1][ref2]; ok {
+		return []int{w}
+	}
+	return nil
+}
+
+// ParallelEdges - возвращает веса всех связей из value1 в value2, для обычного графа - не более одного
+func (g *Graph) ParallelEdges(value1, value2 string) []int {
+	ref1 := g.getRefOfNode(value1)
+	ref2 := g.getRefOfNode(value2)
+	if ref1 == nil || ref2 == nil {
+		return nil
+	}
+	return append([]int{}, g.weightsBetween(ref1, ref2)...)
+}
+
+// ErrDuplicateEdge - ошибка: связь между узлами уже существует
+var ErrDuplicateEdge = errors.New("Связь между узлами уже существует")
+
+// AddEdgeIfAbsent - добавляет дугу / ребро между узлами, только если связи еще нет (в отличие от AddEdge,
+// существующий вес не перезаписывается). Возвращает true, если связь была добавлена
+func (g *Graph) AddEdgeIfAbsent(value1, value2 string, distance int) bool {
+	if _, ok := g.edgeWeight(value1, value2); ok {
+		return false
+	}
+	return g.AddEdge(value1, value2, distance) == nil
+}
+
+// AddDirectedEdge - добавляет одну дугу из from в to независимо от ориентированности графа
+// (например, улица с односторонним движением на неориентированной карте).
+// Существующая дуга перезаписывается (в мультиграфе добавляется параллельная).
+// Алгоритмы работают со списками смежности, поэтому такая дуга проходится только в одном направлении,
+// а методы, рассчитывающие на симметричность неориентированного графа (выгрузка в файл, JSON и т.п.),
+// могут ее потерять или записать как обычное ребро. Если петли запрещены, то для from == to возвращает ErrSelfLoop
+func (g *Graph) AddDirectedEdge(from, to string, w int) error {
+	if g.no_self_loops && from == to {
+		return ErrSelfLoop
+	}
+	ref1 := g.addNode(from)
+	ref2 := g.addNode(to)
+	if !g.is_suspended {
+		w = -1
+	}
+	g.setEdge(ref1, ref2, w)
+	return nil
+}
+
+// ErrNoEdge - ошибка: связи между узлами не существует
+var ErrNoEdge = errors.New("Связи между узлами не существует")
+
+// SetWeight - заменяет вес существующей дуги / ребра из value1 в value2 на w, не пересоздавая узлы
+// (в неориентированном графе - в обоих направлениях, в мультиграфе - у всех параллельных связей).
+// Если какого-то узла нет, граф невзвешенный или связи нет (ErrNoEdge), то возвращает ошибку
+func (g *Graph) SetWeight(value1, value2 string, w int) error {
+	if err := validateNode(g, value1); err != nil {
+		return err
+	}
+	if err := validateNode(g, value2); err != nil {
+		return err
+	}
+	if !g.is_suspended {
+		return errors.New("Граф должен быть взвешенным")
+	}
+	ref1 := g.getRefOfNode(value1)
+	ref2 := g.getRefOfNode(value2)
+	if _, ok := g.edges[ref1][ref2]; !ok {
+		return ErrNoEdge
+	}
+	g.replaceWeight(ref1, ref2, w)
+	if !g.is_oriented && ref1 != ref2 {
+		g.replaceWeight(ref2, ref1, w)
+	}
+	return nil
+}
+
+// replaceWeight - заменяет вес дуги из ref1 в ref2 (и всех параллельных ей), если она существует
+func (g *Graph) replaceWeight(ref1, ref2 *Node, w int) {
+	if _, ok := g.edges[ref1][ref2]; !ok {
+		return
+	}
+	g.edges[ref1][ref2] = w
+	if g.is_multigraph {
+		for i := range g.parallel[ref1][ref2] {
+			g.parallel[ref1][ref2][i] = w
+		}
+	}
+}
+
+// ErrSelfLoop - ошибка добавления петли в граф, в котором петли запрещены
+var ErrSelfLoop = errors.New("Петли в графе запрещены")
+
+// AllowSelfLoops - разрешает или запрещает добавление петель.
+// Уже существующие петли при запрете не удаляются
+func (g *Graph) AllowSelfLoops(allow bool) {
+	g.no_self_loops = !allow
+}
+
+// HasSelfLoop - проверяет, есть ли у вершины value петля
+func (g *Graph) HasSelfLoop(value string) bool {
+	ref := g.getRefOfNode(value)
+	if ref == nil {
+		return false
+	}
+	_, ok := g.edges[ref][ref]
+	return ok
+}
+
+// SelfLoops - возвращает отсортированный список вершин, у которых есть петля
+func (g *Graph) SelfLoops() []string {
+	result := []string{}
+	for node, v := range g.edges {
+		if _, ok := v[node]; ok {
+			result = append(result, node.toString())
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// SetNodeAttr - задает узлу атрибут key со значением value (например, координаты или категорию).
+// Если узла нет, возвращает ошибку
+func (g *Graph) SetNodeAttr(node, key, value string) error {
+	if err := validateNode(g, node); err != nil {
+		return err
+	}
+	ref := g.getRefOfNode(node)
+	if g.attributes == nil {
+		g.attributes = make(map[*Node]map[string]string)
+	}
+	if g.attributes[ref] == nil {
+		g.attributes[ref] = make(map[string]string)
+	}
+	g.attributes[ref][key] = value
+	return nil
+}
+
+// NodeAttr - возвращает значение атрибута key узла и признак его наличия
+func (g *Graph) NodeAttr(node, key string) (string, bool) {
+	ref := g.getRefOfNode(node)
+	if ref == nil {
+		return "", false
+	}
+	value, ok := g.attributes[ref][key]
+	return value, ok
+}
+
+// RemoveEdge - удаляет дугу / ребро (в мультиграфе - все параллельные связи)
+// и возвращает признак того, что что-то было удалено.
+// Если какого-то узла не существует, то возвращает ошибку
+func (g *Graph) RemoveEdge(value1, value2 string) (bool, error) {
+	if err := validateNode(g, value1); err != nil {
+		return false, err
+	}
+	if err := validateNode(g, value2); err != nil {
+		return false, err
+	}
+	node1 := g.getRefOfNode(value1)
+	node2 := g.getRefOfNode(value2)
+	_, removed := g.edges[node1][node2]
+	g.deleteEdge(node1, node2)
+	if !g.is_oriented {
+		_, ok := g.edges[node2][node1]
+		removed = removed || ok
+		g.deleteEdge(node2, node1)
+	}
+	return removed, nil
+}
+
+// removeEdge - удаляет дугу / ребро (в мультиграфе - все параллельные связи),
+// если какого-то элемента не существует, то ничего не удаляет и выводит сообщение
+func (g *Graph) removeEdge(value1, value2 string) {
+	if _, err := g.RemoveEdge(value1, value2); err != nil {
+		fmt.Fprintln(g.writer(), "Оба узла должны существовать в графе", value1, value2)
+	}
+}
+
+// RemoveNode - удаляет узел и все входящие и исходящие ребра / дуги,
+// если узла не существует, то возвращает ошибку.
+// Сначала собираются узлы, имеющие связь с удаляемым, затем удаляются связи и сам узел
+func (g *Graph) RemoveNode(value string) error {
+	if err := validateNode(g, value); err != nil {
+		return err
+	}
+	node := g.getRefOfNode(value)
+	var sources []*Node
+	for k, v := range g.edges {
+		if _, ok := v[node]; ok && k != node {
+			sources = append(sources, k)
+		}
+	}
+	for _, k := range sources {
+		g.deleteEdge(k, node)
+	}
+	g.dropNode(node)
+	return nil
+}
+
+// dropNode - удаляет узел вместе с исходящими связями и всеми данными о нем,
+// входящие связи к этому моменту должны быть уже удалены
+func (g *Graph) dropNode(node *Node) {
+	for next := range g.edges[node] {
+		g.in_degree[next]--
+	}
+	delete(g.edges, node)
+	if g.parallel != nil {
+		delete(g.parallel, node)
+	}
+	delete(g.attributes, node)
+	delete(g.in_degree, node)
+	delete(g.index, node.toString())
+}
+
+// Clear - удаляет все вершины и связи, сохраняя ориентированность, взвешенность, политику петель и поток вывода.
+// Словари очищаются, а не создаются заново, поэтому граф можно переиспользовать без новых выделений памяти
+func (g *Graph) Clear() {
+	for node := range g.edges {
+		delete(g.edges, node)
+	}
+	for node := range g.in_degree {
+		delete(g.in_degree, node)
+	}
+	for node := range g.parallel {
+		delete(g.parallel, node)
+	}
+	for node := range g.attributes {
+		delete(g.attributes, node)
+	}
+	for value := range g.index {
+		delete(g.index, value)
+	}
+}
+
+// RemoveAllEdges - удаляет все связи графа, сохраняя вершины и их атрибуты
+func (g *Graph) RemoveAllEdges() {
+	for _, v := range g.edges {
+		for next := range v {
+			delete(v, next)
+		}
+	}
+	for node := range g.in_degree {
+		delete(g.in_degree, node)
+	}
+	for node := range g.parallel {
+		delete(g.parallel, node)
+	}
+}
+
+// PrintDataInFile - выводит данные о графе в файл, данные пригодны для создания нового графа
+// с помощью NewGraphFromFile
+func (g *Graph) PrintDataInFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	if g.is_oriented {
+		fmt.Fprintln(w, "oriented")
+	} else {
+		fmt.Fprintln(w, "unoriented")
+	}
+	if g.is_suspended {
+		fmt.Fprintln(w, "suspended")
+	} else {
+		fmt.Fprintln(w, "unsuspended")
+	}
+	// Для невзвешенного графа вес всегда записывается как -1, при чтении он игнорируется.
+	// Ребро неориентированного графа хранится в обоих списках смежности, но записывается один раз
+	emitted := make(map[[2]*Node]bool)
+	for k := range g.edges {
+		for k2 := range g.edges[k] {
+			if !g.is_oriented {
+				if emitted[[2]*Node{k2, k}] {
+					continue
+				}
+				emitted[[2]*Node{k, k2}] = true
+			}
+			for _, weight := range g.weightsBetween(k, k2) {
+				if !g.is_suspended {
+					weight = -1
+				}
+				fmt.Fprintf(w, "%s %s %d\n", k.toString(), k2.toString(), weight)
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+/*
+
+Вспомогательные функции, необходимые для выполнения задания:
+
+*/
+
+// validateData - проверка входных данных из файла
+// Файл без дуг / ребер (только заголовок) допустим и задает пустой граф
+func validateData(str []string) error {
+	if len(str) < 2 {
+		return errors.New("Файл должен содержать как минимум строки с типом ориентации и взвешенности графа")
+	}
+
+	if !(str[0] == "oriented" || str[0] == "unoriented") {
+		return errors.New("Неправильный тип ориентации графа")
+	}
+
+	if !(str[1] == "suspended" || str[1] == "unsuspended") {
+		return errors.New("Неправильный тип взвешенности графа")
+	}
+	return nil
+}
+
+// SetOutput - задает поток, в который выводят результат методы печати и алгоритмы с выводом.
+// Если w равен nil, используется os.Stdout
+func (g *Graph) SetOutput(w io.Writer) {
+	g.output = w
+}
+
+// writer - возвращает поток вывода графа
+func (g *Graph) writer() io.Writer {
+	if g.output == nil {
+		return os.Stdout
+	}
+	return g.output
+}
+
+// HasNode - проверяет, есть ли в графе вершина value
+func (g *Graph) HasNode(value string) bool {
+	return g.getRefOfNode(value) != nil
+}
+
+// IsOriented - проверяет, является ли граф ориентированным
+func (g *Graph) IsOriented() bool {
+	return g.is_oriented
+}
+
+// IsWeighted - проверяет, является ли граф взвешенным
+func (g *Graph) IsWeighted() bool {
+	return g.is_suspended
+}
+
+// getRefOfNode - возвращает ссылку на узел или nil (поиск по индексу значений)
+func (g *Graph) getRefOfNode(value string) *Node {
+	return g.index[value]
+}
+
+// Edges - возвращает все дуги / ребра графа, отсортированные по узлам и весу
+// (ребро неориентированного графа возвращается один раз)
+func (g *Graph) Edges() []Edge {
+	return g.edgeList()
+}
+
+// nodeValues - возвращает отсортированный список значений всех узлов графа
+func (g *Graph) nodeValues() []string {
+	result := make([]string, 0, len(g.edges))
+	for k := range g.edges {
+		result = append(result, k.toString())
+	}
+	sort.Strings(result)
+	return result
+}
+
+// edgeList - возвращает отсортированный список всех дуг / ребер графа (включая параллельные),
+// ребро неориентированного графа попадает в список один раз
+func (g *Graph) edgeList() []Edge {
+	result := []Edge{}
+	for k, v := range g.edges {
+		for k2, w := range v {
+			if !g.is_oriented && k.toString() > k2.toString() {
+				continue
+			}
+			if !g.is_multigraph {
+				result = append(result, Edge{k.toString(), k2.toString(), w})
+				continue
+			}
+			for _, pw := range g.parallel[k][k2] {
+				result = append(result, Edge{k.toString(), k2.toString(), pw})
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].From != result[j].From {
+			return result[i].From < result[j].From
+		}
+		if result[i].To != result[j].To {
+			return result[i].To < result[j].To
+		}
+		return result[i].Weight < result[j].Weight
+	})
+	return result
+}
+
+// printNodes - выводит все узлы в графе
+func (g *Graph) printNodes(w io.Writer) {
+	for k := range g.edges {
+		fmt.Fprintln(w, "Узел:", k.toString())
+	}
+}
+
+// printEdges - выводит узлы и их связи
+func (g *Graph) printEdges(w io.Writer) {
+	for k, v := range g.edges {
+		for k2, v2 := range v {
+			if g.is_suspended {
+				fmt.Fprintln(w, k.toString(), "->", k2.toString(), ":", v2)
+			} else {
+				fmt.Fprintln(w, k.toString(), "->", k2.toString())
+			}
+		}
+	}
+}
+
+// Выводит узлы и связи в комфортном виде
+func (g *Graph) printEdgesComfort(w io.Writer) {
+	for k, v := range g.edges {
+		fmt.Fprintln(w, k.toString()+":")
+		for k2, v2 := range v {
+			if g.is_suspended {
+				fmt.Fprintln(w, "\t", k2.toString(), ":", v2)
+			} else {
+				fmt.Fprintln(w, "\t", k2.toString())
+			}
+		}
+	}
+}
+
+// PrintInformationAboutGraph - выводит всю информацию о графе в поток вывода графа
+func (g *Graph) PrintInformationAboutGraph() {
+	g.WriteInfo(g.writer())
+}
+
+// WriteInfo - выводит всю информацию о графе в w
+func (g *Graph) WriteInfo(w io.Writer) {
+	fmt.Fprintln(w, "Граф:")
+	if g.is_oriented {
+		fmt.Fprintln(w, "- Ориентированный")
+	} else {
+		fmt.Fprintln(w, "- Неориентированный")
+	}
+	if g.is_suspended {
+		fmt.Fprintln(w, "- Взвешенный")
+	} else {
+		fmt.Fprintln(w, "- Невзвешенный")
+	}
+	fmt.Fprintln(w, "Узлы:")
+	g.printNodes(w)
+	fmt.Fprintln(w, "Связи в графе:")
+	g.printEdgesComfort(w)
+	fmt.Fprintln(w, "===============")
+}
+
+/*
+Задачи:
+Блок 1А:
+4 - GetInclinationDegree - возвращает полустепень захода указанной вершины
+20 - PrintAllNonContiguousNodes - выводит все вершины графа, не смежные с данной
+
+Блок 1Б:
+18 - GetNewGraphWithoutOddNodes - возвращает новый граф без вершин с нечетными степенями
+
+*/
+
+// GetInclinationDegree - возвращает полустепень захода указанной вершины,
+// для неориентированного графа она совпадает со степенью вершины.
+// Если вершины нет, то возвращает ошибку
+func (g *Graph) GetInclinationDegree(value string) (int, error) {
+	if err := validateNode(g, value); err != nil {
+		return 0, err
+	}
+	return g.InDegree(value), nil
+}
+
+// PrintAllNonContiguousNodes - выводит все вершины графа, не смежные с данной
+func (g *Graph) PrintAllNonContiguousNodes(value string) {
+	nodes, err := g.NonAdjacentNodes(value)
+	if err != nil {
+		fmt.Fprintln(g.writer(), err.Error())
+		return
+	}
+	if len(nodes) == 0 {
+		fmt.Fprintln(g.writer(), "Все вершины графа смежны с данной")
+		return
+	}
+	fmt.Fprintln(g.writer(), "Не смежные вершины:")
+	for _, n := range nodes {
+		fmt.Fprintln(g.writer(), n)
+	}
+}
+
+// NonAdjacentNodes - возвращает отсортированный список вершин, не смежных с данной
+// (нет связи ни в одном направлении). Сама вершина в список не попадает
+func (g *Graph) NonAdjacentNodes(value string) ([]string, error) {
+	if err := validateNode(g, value); err != nil {
+		return nil, err
+	}
+	node := g.getRefOfNode(value)
+	result := []string{}
+	for key := range g.edges {
+		if key == node {
+			continue
+		}
+		_, in := g.edges[key][node]
+		_, out := g.edges[node][key]
+		if !in && !out {
+			result = append(result, key.toString())
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// GetNewGraphWithoutOddNodes - возвращает граф, построенный однократным удалением вершин с нечетными степенями
+func (g *Graph) GetNewGraphWithoutOddNodes() *Graph {
+	return g.RemoveOddDegreeNodes(false)
+}
+
+// RemoveOddDegreeNodes - возвращает новый граф без вершин с нечетными степенями.
+// При repeat = false вершины удаляются однократно (степени считаются в исходном графе),
+// при repeat = true удаление повторяется, пока в графе остаются вершины с нечетными степенями
+func (g *Graph) RemoveOddDegreeNodes(repeat bool) *Graph {
+	newG := NewCopiedGraph(g)
+	for {
+		oddNodes := []string{}
+		// создаем срез нечетных вершин графа
+		for k := range newG.edges {
+			if newG.Degree(k.toString())%2 != 0 {
+				oddNodes = append(oddNodes, k.toString())
+			}
+		}
+		// удаляем вершины
+		for _, node := range oddNodes {
+			newG.RemoveNode(node)
+		}
+		if !repeat || len(oddNodes) == 0 {
+			return newG
+		}
+	}
+}
+
+// GetCurrentWay - выводит кратчайший путь из u1 в u2, не проходящий через v
+func (g *Graph) GetCurrentWay(u1, u2, v string) {
+	path, _, err := g.ShortestPathAvoiding(u1, u2, []string{v})
+	if err != nil {
+		fmt.Fprintln(g.writer(), err.Error())
+		return
+	}
+	for _, node := range path {
+		fmt.Fprintln(g.writer(), node)
+	}
+}
+
+// IsGraphTreeOrForest - проверяет граф на дерево или лес
+func (g *Graph) IsGraphTreeOrForest() {
+	countOfComponents := false // показатель того, что не 1 компонента связности
+	count := len(g.edges)
+	// цикл для всех вершин
+	for n := range g.edges {
+		visited := []string{n.toString()} // список посещений
+		isNoCycle := true                 // нет ли цикла
+		workingGraph := NewCopiedGraph(g) // копия графа для удаления ребер
+		nodeValue := workingGraph.getRefOfNode(n.toString())
+		c := 0 // кол-во ребер
+		workingGraph.dfsForTree(nodeValue, nodeValue, &visited, &isNoCycle, &c)
+		if isNoCycle && len(visited) == c+1 { // проверка на дерево: n = m +1
+			count -= 1
+			if len(g.edges) != c+1 {
+				countOfComponents = true // компонент связности более 1
+			}
+		}
+	}
+	// Если 1 компонента связности
+	if !countOfComponents {
+		if count == 0 {
+			fmt.Fprintln(g.writer(), "Граф является деревом")
+		} else {
+			fmt.Fprintln(g.writer(), "Граф не является ни деревом, ни лесом")
+		}
+	} else {
+		if count == 0 {
+			fmt.Fprintln(g.writer(), "Граф является лесом")
+		} else {
+			fmt.Fprintln(g.writer(), "Граф не яввляется ни деревом, ни лесом")
+		}
+	}
+}
+
+// dfsForTree - выполняет обход графа в глубину, проверяя компоненту на "дерево"
+func (g *Graph) dfsForTree(v, currentV *Node, visited *[]string, isNoCycle *bool, count *int) {
+	if len(*visited) == len(g.edges) {
+		return
+	}
+	// есть цикл или петля
+	if _, ok := g.edges[currentV][v]; ok {
+		fmt.Fprintln(g.writer(), "Связь:", currentV.toString(), v.toString())
+		*isNoCycle = false
+	}
+
+	// проссматриваем всех соседей до первого непройденного
+	for n := range g.edges[currentV] {
+		// проверяем, что не заходили в узел
+		isVisited := false
+		for _, t := range *visited {
+			if t == n.toString() {
+				isVisited = true
+			}
+		}
+		// если не заходили
+		if !isVisited {
+			*visited = append(*visited, n.toString())
+			g.removeEdge(currentV.toString(), n.toString())
+			// есть ли связь с уже проссмотренными
+			for _, t := range *visited {
+				if _, ok := g.edges[n][g.getRefOfNode(t)]; ok {
+					*isNoCycle = false
+				}
+			}
+			*count += 1
+			g.dfsForTree(v, n, visited, isNoCycle, count)
+		}
+	}
+}
+
+// Bfs - выполняет обход графа в ширину, начиная с указанной вершины
+// isPrintNeeded - указатель того нужен вывод в консоль или нет
+func (g *Graph) Bfs(v string, isPrintNeeded bool) []string {
+	visited, _ := g.BFSOrder(v)
+	if isPrintNeeded {
+		for _, n := range visited {
+			fmt.Fprintln(g.writer(), "Узел", n)
+		}
+	}
+	return visited
+}
+
+// Dfs - Выполняет обход графа в глубину, начиная с указанной вершины
+func (g *Graph) Dfs(v string) {
+	visited, _ := g.DFSOrder(v)
+	for _, n := range visited {
+		fmt.Fprintln(g.writer(), "Узел", n)
+	}
+}
+
+// BFSOrder - возвращает порядок обхода графа в ширину, начиная с указанной вершины.
+// Обход идет по исходящим дугам и посещает только достижимые вершины, соседи просматриваются по возрастанию значений
+func (g *Graph) BFSOrder(start string) ([]string, error) {
+	if err := validateNode(g, start); err != nil {
+		return nil, err
+	}
+	node := g.getRefOfNode(start)
+	visited := map[*Node]bool{node: true} // посещенные вершины
+	queue := []*Node{node}                // очередь для посещения
+	order := []string{}
+	for len(queue) > 0 {
+		currentElement := queue[0]
+		queue = queue[1:]
+		order = append(order, currentElement.toString())
+		// Цикл по всем связям
+		for _, element := range g.sortedNeighbors(currentElement) {
+			// Если не посещали данный узел
+			if !visited[element] {
+				visited[element] = true
+				queue = append(queue, element)
+			}
+		}
+	}
+	return order, nil
+}
+
+// DFSOrder - возвращает порядок обхода графа в глубину, начиная с указанной вершины.
+// Обход идет по исходящим дугам и посещает только достижимые вершины, соседи просматриваются по возрастанию значений
+func (g *Graph) DFSOrder(start string) ([]string, error) {
+	if err := validateNode(g, start); err != nil {
+		return nil, err
+	}
+	node := g.getRefOfNode(start)
+	visited := map[*Node]bool{node: true} // посещенные вершины
+	order := []string{}
+	g.dfsHelper(node, visited, &order)
+	return order, nil
+}
+
+// DFSOrderIterative - возвращает тот же порядок обхода в глубину, что и DFSOrder, но использует явный стек
+// вместо рекурсии, поэтому подходит для очень глубоких графов (например, длинных цепочек).
+// Если вершины нет, возвращает nil
+func (g *Graph) DFSOrderIterative(start string) []string {
+	node := g.getRefOfNode(start)
+	if node == nil {
+		return nil
+	}
+	visited := map[*Node]bool{node: true}
+	neighbors := map[*Node][]*Node{node: g.sortedNeighbors(node)} // соседи вершин стека
+	stack := []*Node{node}
+	order := []string{start}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		// Первый непосещенный сосед вершины на вершине стека, просмотренные соседи отбрасываются
+		var nextNode *Node
+		for len(neighbors[current]) > 0 && nextNode == nil {
+			if candidate := neighbors[current][0]; !visited[candidate] {
+				nextNode = candidate
+			}
+			neighbors[current] = neighbors[current][1:]
+		}
+		if nextNode == nil {
+			delete(neighbors, current)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		visited[nextNode] = true
+		order = append(order, nextNode.toString())
+		neighbors[nextNode] = g.sortedNeighbors(nextNode)
+		stack = append(stack, nextNode)
+	}
+	return order
+}
+
+// BFSLevels - возвращает для каждой вершины, достижимой из start, количество дуг / ребер кратчайшего пути
+// (уровень обхода в ширину, start имеет уровень 0). Веса не учитываются, недостижимые вершины
+// в словарь не попадают. Если вершины нет, возвращает nil
+func (g *Graph) BFSLevels(start string) map[string]int {
+	node := g.getRefOfNode(start)
+	if node == nil {
+		return nil
+	}
+	levels := g.hopsFrom(node)
+	result := make(map[string]int, len(levels))
+	for n, d := range levels {
+		result[n.toString()] = d
+	}
+	return result
+}
+
+// hopsFrom - обходом в ширину находит количество дуг / ребер кратчайшего пути из source до каждой достижимой вершины
+func (g *Graph) hopsFrom(source *Node) map[*Node]int {
+	distances := map[*Node]int{source: 0}
+	queue := []*Node{source}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for next := range g.edges[current] {
+			if _, ok := distances[next]; !ok {
+				distances[next] = distances[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return distances
+}
+
+// dfsHelper - вспомогательная функция для обхода графа в глубину
+func (g *Graph) dfsHelper(node *Node, visited map[*Node]bool, order *[]string) {
+	*order = append(*order, node.toString())
+	for _, nextNode := range g.sortedNeighbors(node) {
+		if !visited[nextNode] {
+			visited[nextNode] = true
+			g.dfsHelper(nextNode, visited, order)
+		}
+	}
+}
+
+// ErrDisconnected - ошибка алгоритмов, требующих связного графа
+var ErrDisconnected = errors.New("Граф является несвязным!")
+
+// Prim - реализация алгоритма Прима: строит минимальное остовное дерево, начиная с вершины start.
+// Возвращает дерево (неориентированный граф с той же взвешенностью) и его суммарный вес
+// (для невзвешенного графа - количество ребер). Если вершины нет или граф несвязный, возвращает ошибку
+func (g *Graph) Prim(start string) (*Graph, int, error) {
+	if err := validateNode(g, start); err != nil {
+		return nil, 0, err
+	}
+	result := NewEmptyGraph()
+	result.is_oriented = false
+	result.is_suspended = g.is_suspended
+	// Проверка графа на связность
+	if !g.IsConnected() {
+		return nil, 0, ErrDisconnected
+	}
+	visited := map[*Node]bool{g.getRefOfNode(start): true} // посещенные вершины
+	result.addNode(start)
+	total := 0
+	for len(visited) < len(g.edges) {
+		weight, element, parent, found := g.searchMin(visited)
+		if !found {
+			// Ребер к непосещенным вершинам не осталось (например, в орграфе не все вершины достижимы из начальной)
+			return nil, 0, ErrDisconnected
+		}
+		visited[element] = true
+		result.AddEdge(parent, element.toString(), weight)
+		total += g.cost(weight)
+	}
+	return result, total, nil
+}
+
+// searchMin - ищет ребро минимального веса, один конец которого уже посещен, а другой - нет.
+// Возвращает вес, новую вершину, посещенный конец и false, если таких ребер нет.
+// При равных весах выбирается ребро с меньшими по значению концами
+func (g *Graph) searchMin(visited map[*Node]bool) (int, *Node, string, bool) {
+	found := false
+	min := 0
+	var element, parent *Node
+	for t := range visited {
+		for elem, w := range g.edges[t] {
+			if visited[elem] {
+				continue
+			}
+			better := !found || w < min ||
+				(w == min && (t.toString() < parent.toString() ||
+					t.toString() == parent.toString() && elem.toString() < element.toString()))
+			if better {
+				found = true
+				min = w
+				element = elem
+				parent = t
+			}
+		}
+	}
+	if !found {
+		return 0, nil, "", false
+	}
+	return min, element, parent.toString(), true
+}
+
+// floydInfinity - недостижимое расстояние в алгоритме Флойда,
+// половина максимального значения, чтобы сумма двух таких расстояний не переполнялась
+const floydInfinity = math.MaxInt / 2
+
+// Floyd - реализация алгоритма Флойда, находит кратчайшие пути между всеми парами вершин.
+// Возвращает словарь расстояний dist[u][v] и словарь предков pred[u][v] - вершину, предшествующую v
+// на кратчайшем пути из u. Недостижимые пары в словари не попадают, расстояние от вершины
+// до самой себя равно 0 и предка не имеет. Для невзвешенного графа длина ребра равна 1
+func (g *Graph) Floyd() (map[string]map[string]int, map[string]map[string]string) {
+	dist, pred, _ := g.FloydCtx(context.Background())
+	return dist, pred
+}
+
+// FloydCtx - алгоритм Флойда с возможностью отмены: контекст проверяется на каждой итерации внешнего цикла,
+// при отмене или истечении срока возвращается ctx.Err()
+func (g *Graph) FloydCtx(ctx context.Context) (map[string]map[string]int, map[string]map[string]string, error) {
+
+	// Составляем матрицу
+	res := make(map[string]map[string]int)
+
+	// Составляем пути
+	path := make(map[string]map[string]string)
+
+	// Заполняем матрицу
+	for node1 := range g.edges {
+		res[node1.toString()] = map[string]int{}
+		for node2 := range g.edges {
+			// Если между node1 и node2 есть ребро, его и запоминаем
+			if distance, ok := g.edges[node1][node2]; ok && node1.toString() != node2.toString() {
+				res[node1.toString()][node2.toString()] = g.cost(distance)
+			} else {
+				res[node1.toString()][node2.toString()] = floydInfinity // Иначе присваиваем недостижимое значение
+			}
+		}
+	}
+
+	// Заполняем список путей: для каждой дуги предок конца - ее начало.
+	// Недостижимые пары в список не попадают, достижимость определяется по расстоянию
+	for node1 := range res {
+		path[node1] = make(map[string]string)
+		for node2 := range res {
+			if node1 != node2 && res[node1][node2] != floydInfinity {
+				path[node1][node2] = node1
+			}
+		}
+	}
+
+	// Сам алгоритм
+	// Внешний цикл по всем вершинам графа
+	for n1 := range res {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		// Просматриваем строчку I
+		for n2 := range res {
+			// Просматриваем строчку II
+			for n3 := range res {
+				// Формируем новое расстояние
+				// Задаемся вопросом: быстрее ли пройти через внешнюю вершину или напрямую
+				// Недостижимые значения не складываем, чтобы не получить переполнение
+				if res[n2][n1] != floydInfinity && res[n1][n3] != floydInfinity &&
+					res[n2][n1]+res[n1][n3] < res[n2][n3] {
+					res[n2][n3] = res[n2][n1] + res[n1][n3]
+					path[n2][n3] = path[n1][n3]
+				}
+			}
+		}
+	}
+
+	// Формируем результат без недостижимых пар
+	dist := make(map[string]map[string]int, len(res))
+	pred := make(map[string]map[string]string, len(res))
+	for n, v := range res {
+		dist[n] = map[string]int{n: 0}
+		pred[n] = map[string]string{}
+		for t, d := range v {
+			if n != t && d != floydInfinity {
+				dist[n][t] = d
+				pred[n][t] = path[n][t]
+			}
+		}
+	}
+	return dist, pred, nil
+}
+
+// PrintFloyd - выводит результат алгоритма Флойда: кратчайшие расстояния и пути между всеми парами вершин
+func (g *Graph) PrintFloyd(dist map[string]map[string]int, pred map[string]map[string]string) {
+	fmt.Fprintln(g.writer(), "Кратчайшие пути между всеми парами вершин:")
+	for n, v := range dist {
+		for t, d := range v {
+			if n != t {
+				fmt.Fprintln(g.writer(), "Кратчайшее расстояние между", n, "и", t, "составляет", d, "путь:")
+				fmt.Fprint(g.writer(), n, " -> ")
+				g.printPath(pred, n, t)
+				fmt.Fprint(g.writer(), t)
+				fmt.Fprintln(g.writer())
+			}
+		}
+	}
+}
+
+// printPath - вывод пути от одной вершины до другой
+func (g *Graph) printPath(path map[string]map[string]string, n, t string) {
+	if path[n][t] == n {
+		return
+	}
+	g.printPath(path, n, path[n][t])
+	fmt.Fprint(g.writer(), path[n][t], " -> ")
+}
+
+// min - возвращает минимальное значение из двух переданных параметров
+func min(a, b int) int {
+	if a < b {
+		return a
+	} else {
+		return b
+	}
+}
+
+// Deikstra - алгоритм Дейкстры, находит минимальные пути от вершины до всех остальных
+// и возвращает словарь: вершина -> кратчайшее расстояние от источника до нее.
+// Недостижимые вершины в словарь не попадают, для невзвешенного графа длина ребра равна 1.
+// Если вершины нет или в графе есть связи отрицательного веса (ErrNegativeWeight), то возвращает ошибку
+func (g *Graph) Deikstra(value string, isNeedOutput bool) (map[string]int, error) {
+	if err := validateNode(g, value); err != nil {
+		return nil, err
+	}
+	if g.HasNegativeEdge() {
+		return nil, ErrNegativeWeight
+	}
+	result := make(map[string]int)
+	for n, d := range g.deikstra(g.getRefOfNode(value), isNeedOutput) {
+		result[n.toString()] = d
+	}
+	return result, nil
+}
+
+// deikstra - находит кратчайшие расстояния от вершины до всех достижимых вершин алгоритмом Дейкстры на куче
+// (см. dijkstra) и при необходимости выводит их, отмечая недостижимые вершины
+func (g *Graph) deikstra(beginNode *Node, isNeedOutput bool) map[*Node]int {
+	distances, _ := g.dijkstra(beginNode)
+
+	// Вывод всех кратчайших расстояний от источника до остальных вершин (опционально)
+	if isNeedOutput {
+		fmt.Fprintln(g.writer(), "Кратчайшие расстояние от вершины:", beginNode.toString())
+		for _, n := range g.sortedNodes() {
+			if d, ok := distances[n]; ok {
+				fmt.Fprintln(g.writer(), "Минимальное расстояние от вершины:", beginNode.toString(), "до вершины:", n.toString(), "равно:", d)
+			} else {
+				fmt.Fprintln(g.writer(), "Вершина:", n.toString(), "недостижима из вершины:", beginNode.toString())
+			}
+		}
+	}
+	return distances
+}
+
+// GetRadiusOfGraph - находит радиус графа - минимальный из эксцентриситетов
+func (g *Graph) GetRadiusOfGraph() {
+	radius, err := g.radius(context.Background(), true)
+	if err != nil {
+		fmt.Fprintln(g.writer(), err.Error())
+		return
+	}
+	fmt.Fprintln(g.writer(), "Радиус графа равен:", radius)
+}
+
+// RadiusCtx - возвращает радиус графа - наименьший эксцентриситет вершины (наибольшее кратчайшее расстояние
+// от нее до остальных вершин, для невзвешенного графа длина ребра равна 1). Вершина, из которой достижимы
+// не все вершины, имеет бесконечный эксцентриситет и не учитывается; если таковы все вершины,
+// возвращает ErrDisconnected. Контекст проверяется перед запуском алгоритма Дейкстры из каждой вершины,
+// при отмене или истечении срока возвращается ctx.Err(). Если граф пуст или в нем есть связи
+// отрицательного веса (ErrNegativeWeight), возвращает ошибку
+func (g *Graph) RadiusCtx(ctx context.Context) (int, error) {
+	return g.radius(ctx, false)
+}
+
+// radius - находит радиус графа, isNeedOutput включает вывод расстояний из каждой вершины
+func (g *Graph) radius(ctx context.Context, isNeedOutput bool) (int, error) {
+	if len(g.edges) == 0 {
+		return 0, errors.New("Граф пуст")
+	}
+	if g.HasNegativeEdge() {
+		return 0, ErrNegativeWeight
+	}
+	radius, found := 0, false
+	for _, n := range g.sortedNodes() {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		distances := g.deikstra(n, isNeedOutput) // Кратчайшие расстояния от текущей вершины
+		if len(distances) != len(g.edges) {
+			continue // эксцентриситет бесконечен
+		}
+		eccentricity := 0
+		for _, d := range distances {
+			if d > eccentricity {
+				eccentricity = d
+			}
+		}
+		if !found || eccentricity < radius {
+			radius, found = eccentricity, true
+		}
+	}
+	if !found {
+		return 0, ErrDisconnected
+	}
+	return radius, nil
+}
+
+// ErrNegativeCycle - ошибка алгоритма Беллмана-Форда: в графе есть цикл отрицательного веса
+var ErrNegativeCycle = errors.New("В графе есть отрицательный цикл!")
+
+// Bellman - алгоритм Беллмана, в данной реализации позволяет вывести кратчайшее растояние между двумя вершинами
+func (g *Graph) Bellman(from, to string, isNeedOutput bool) {
+	dist, _, err := g.BellmanFord(from)
+	if err != nil {
+		fmt.Fprintln(g.writer(), err.Error())
+		return
+	}
+
+	// Если нужен вывод кратчайших путей до всех вершин (опционально)
+	if isNeedOutput {
+		for node, d := range dist {
+			if node != from {
+				fmt.Fprintln(g.writer(), from, "->", node, d)
+			}
+		}
+	}
+
+	// Если путь не найден или найден
+	p, d, err := g.ShortestPathBF(from, to)
+	if err != nil {
+		fmt.Fprintln(g.writer(), "Кратчайшего пути не существует")
+		return
+	}
+	fmt.Fprintln(g.writer(), "Расстояние между", from, "и", to, "составляет:", d)
+	fmt.Fprintln(g.writer(), "Путь:")
+	fmt.Fprintln(g.writer(), strings.Join(p, " -> "))
+}
+
+// BellmanFord - алгоритм Беллмана-Форда, находит кратчайшие расстояния от from до всех достижимых вершин
+// и их предков на кратчайших путях. Допускает отрицательные веса, для невзвешенного графа длина ребра равна 1.
+// Недостижимые вершины в словари не попадают. Если из from достижим отрицательный цикл,
+// возвращает ErrNegativeCycle
+func (g *Graph) BellmanFord(from string) (map[string]int, map[string]string, error) {
+	if err := validateNode(g, from); err != nil {
+		return nil, nil, err
+	}
+	// Словарь расстояний, отсутствие вершины - недостижимость
+	res := map[*Node]int{g.getRefOfNode(from): 0}
+	path := make(map[*Node]*Node)
+
+	// Нужно выполнить n - 1 итерацию
+	for i := 0; i < len(g.edges)-1; i++ {
+		changed := false
+		// Проссматриваем все вершины и вычисляем кратчайшие расстояния
+		for n, v := range g.edges {
+			dn, ok := res[n]
+			if !ok {
+				continue
+			}
+			for t, d := range v {
+				// Если расстояние от источника до рассматриваемой больше, чем сумма
+				// расстояний от текущей до рассматриваемой + расстояние от текущей до итерируемой
+				// то обновляем расстояние
+				if dt, ok := res[t]; !ok || dn+g.cost(d) < dt {
+					res[t] = dn + g.cost(d)
+					path[t] = n
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	// Проверка на отрицательные циклы
+	// выполняем еще один шаг и, если удалось укоротить расстояние => есть отрицательный цикл
+	for n, v := range g.edges {
+		if dn, ok := res[n]; ok {
+			for t, d := range v {
+				if dn+g.cost(d) < res[t] {
+					return nil, nil, ErrNegativeCycle
+				}
+			}
+		}
+	}
+
+	dist := make(map[string]int, len(res))
+	pred := make(map[string]string, len(path))
+	for n, d := range res {
+		dist[n.toString()] = d
+	}
+	for n, p := range path {
+		pred[n.toString()] = p.toString()
+	}
+	return dist, pred, nil
+}
+
+// ShortestPathBF - находит кратчайший путь из from в to алгоритмом Беллмана-Форда,
+// возвращает вершины пути и его длину
+func (g *Graph) ShortestPathBF(from, to string) ([]string, int, error) {
+	if err := validateNode(g, to); err != nil {
+		return nil, 0, err
+	}
+	dist, pred, err := g.BellmanFord(from)
+	if err != nil {
+		return nil, 0, err
+	}
+	if _, ok := dist[to]; !ok {
+		return nil, 0, errors.New("Кратчайшего пути не существует")
+	}
+	p := []string{to}
+	for current := to; current != from; {
+		current = pred[current]
+		p = append(p, current)
+	}
+	for i, j := 0, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+	return p, dist[to], nil
+}
+
+/*
+bfsHelper - обход в ширину. Возвращает кратчайшие пути (по сумме дуг) от s до каждой вершины графа.
+Попутно изменяет значение потока из s в каждую вершину.
+*/
+func (g *Graph) bfsHelper(s, t *Node, C, F *map[*Node]map[*Node]int, push *map[*Node]int, pred *map[*Node]*Node) bool {
+	queue := []*Node{}
+	visited := make(map[*Node]bool)
+	for node := range g.edges {
+		visited[node] = false
+	}
+	queue = append(queue, s)
+	visited[s] = true
+	(*pred)[s] = s
+	(*push)[s] = math.MaxInt // из истока можно вытолкнуть сколько угодно
+
+	for {
+		if visited[t] || len(queue) == 0 {
+			break
+		}
+		u := queue[0]
+		queue = queue[1:]
+		for edge := range g.edges {
+			// Если не посещали и поток не превосходит пропускную способность
+			if !visited[edge] && ((*C)[u][edge]-(*F)[u][edge] > 0) {
+				visited[edge] = true
+				queue = append(queue, edge)
+				(*push)[edge] = min((*push)[u], (*C)[u][edge]-(*F)[u][edge])
+				(*pred)[edge] = u
+			}
+		}
+	}
+	return visited[t]
+}
+
+// initPredAndPush - возвращает поток из начальной вершины в v
+// и словарь, показывающий откуда пришли в v (предок)
+func (g *Graph) initPredAndPush() (*map[*Node]int, *map[*Node]*Node) {
+	// Формируем словарь потоков
+	push := make(map[*Node]int)
+	for edge := range g.edges {
+		push[edge] = 0
+	}
+	// Формируем словарь предков
+	pred := make(map[*Node]*Node)
+	for edge := range g.edges {
+		pred[edge] = nil
+	}
+	return &push, &pred
+}
+
+// initFlowsAndBandwidth - возвращаем словарь пропускных способностей и текущих потоков в графе
+func (g *Graph) initFlowsAndBandwidth() (*map[*Node]map[*Node]int, *map[*Node]map[*Node]int) {
+	C := make(map[*Node]map[*Node]int) // Пропускные способности каждой дуги
+	F := make(map[*Node]map[*Node]int) // Текущий поток в графе
+
+	for node1 := range g.edges {
+		C[node1] = map[*Node]int{}
+		F[node1] = map[*Node]int{}
+		for node2 := range g.edges {
+			F[node1][node2] = 0
+			// Пропускные способности параллельных дуг складываются
+			C[node1][node2] = 0
+			for _, d := range g.weightsBetween(node1, node2) {
+				C[node1][node2] += g.cost(d)
+			}
+		}
+	}
+	return &C, &F
+}
+
+// ErrSameSourceSink - ошибка поиска потока: исток совпадает со стоком
+var ErrSameSourceSink = errors.New("Исток и сток должны различаться")
+
+// validateFlowEnds - проверяет, что исток и сток существуют и различаются
+func validateFlowEnds(g *Graph, s, t string) error {
+	if err := validateNode(g, s); err != nil {
+		return err
+	}
+	if err := validateNode(g, t); err != nil {
+		return err
+	}
+	if s == t {
+		return ErrSameSourceSink
+	}
+	return nil
+}
+
+// MaxFlow - алгоритм Форда-Фалкерсона, возвращает величину максимального потока из s (исток) в t (сток).
+// Пропускная способность дуги равна ее весу (для невзвешенного графа - 1, у параллельных связей складывается).
+// Ребро неориентированного графа хранится как две дуги, поэтому является пропускной способностью
+// в обоих направлениях. Если вершин нет или исток совпадает со стоком (ErrSameSourceSink), то возвращает ошибку
+func (g *Graph) MaxFlow(s, t string) (int, error) {
+	return g.MaxFlowCtx(context.Background(), s, t)
+}
+
+// MaxFlowWithAssignment - находит максимальный поток из s в t и возвращает его величину
+// и поток по каждой дуге исходного графа (ключ - пара "откуда, куда"), в словарь попадают только дуги
+// с положительным потоком. Для встречных дуг учитывается итоговый (взаимно сокращенный) поток.
+// Если вершин нет или исток совпадает со стоком (ErrSameSourceSink), то возвращает ошибку
+func (g *Graph) MaxFlowWithAssignment(s, t string) (total int, flow map[[2]string]int, err error) {
+	if err := validateFlowEnds(g, s, t); err != nil {
+		return 0, nil, err
+	}
+	total, _, F := g.fordFulkerson(g.getRefOfNode(s), g.getRefOfNode(t))
+	flow = make(map[[2]string]int)
+	for u, v := range g.edges {
+		for next := range v {
+			if f := (*F)[u][next]; f > 0 {
+				flow[[2]string{u.toString(), next.toString()}] = f
+			}
+		}
+	}
+	return total, flow, nil
+}
+
+// MaxFlowCtx - возвращает величину максимального потока из s в t, контекст проверяется перед поиском
+// каждого увеличивающего пути, при отмене или истечении срока возвращается ctx.Err().
+// Если вершин нет или исток совпадает со стоком (ErrSameSourceSink), то возвращает ошибку
+func (g *Graph) MaxFlowCtx(ctx context.Context, s, t string) (int, error) {
+	if err := validateFlowEnds(g, s, t); err != nil {
+		return 0, err
+	}
+	flow, _, _, err := g.fordFulkersonCtx(ctx, g.getRefOfNode(s), g.getRefOfNode(t))
+	return flow, err
+}
+
+// fordFulkerson - находит максимальный поток из s в t,
+// возвращает его величину, пропускные способности и итоговые потоки в графе
+func (g *Graph) fordFulkerson(s, t *Node) (int, *map[*Node]map[*Node]int, *map[*Node]map[*Node]int) {
+	flow, C, F, _ := g.fordFulkersonCtx(context.Background(), s, t)
+	return flow, C, F
+}
+
+// fordFulkersonCtx - fordFulkerson с проверкой контекста перед поиском каждого увеличивающего пути
+func (g *Graph) fordFulkersonCtx(ctx context.Context, s, t *Node) (int, *map[*Node]map[*Node]int, *map[*Node]map[*Node]int, error) {
+	var u, v *Node
+	flow := 0
+
+	// Инициализация проп. способностей и текущих потоков
+	C, F := g.initFlowsAndBandwidth()
+	if s == t {
+		return 0, C, F, nil // сток сразу достигнут, увеличивающих путей нет
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, nil, nil, err
+		}
+		// Инициализация потомков и предков
+		push, pred := g.initPredAndPush()
+		if !g.bfsHelper(s, t, C, F, push, pred) {
+			break
+		}
+		add := (*push)[t]
+		v = t
+		u = (*pred)[v]
+
+		for {
+			if v == s {
+				break
+			}
+			(*F)[u][v] += add
+			(*F)[v][u] -= add
+			v = u
+			u = (*pred)[v]
+		}
+		flow += add
+	}
+	return flow, C, F, nil
+}
+
+/*
+Вспомогательные функции к задачам:
+- getDegree - возвращает степень вершины
+- InDegree - возвращает полустепень захода вершины
+- OutDegree - возвращает полустепень исхода вершины
+- Degree - возвращает степень вершины
+- InWeightedDegree, OutWeightedDegree, WeightedDegree - суммарный вес входящих, исходящих и инцидентных связей
+- NodesWithDegree - вершины заданной степени
+- DegreeSequence - степенная последовательность графа
+
+*/
+
+// getDegree - возвращает степень вершины
+func (g *Graph) getDegree(value string) int {
+	if g.getRefOfNode(value) == nil {
+		fmt.Fprintln(g.writer(), "Узел не существует в графе")
+		return -1
+	}
+	return g.Degree(value)
+}
+
+// InDegree - возвращает полустепень захода вершины (количество входящих дуг, петля считается один раз).
+// Для неориентированного графа совпадает со степенью. Если вершины нет, возвращает -1
+func (g *Graph) InDegree(value string) int {
+	node := g.getRefOfNode(value)
+	if node == nil {
+		return -1
+	}
+	return g.in_degree[node]
+}
+
+// OutDegree - возвращает полустепень исхода вершины (количество исходящих дуг, петля считается один раз).
+// Для неориентированного графа совпадает со степенью. Если вершины нет, возвращает -1
+func (g *Graph) OutDegree(value string) int {
+	node := g.getRefOfNode(value)
+	if node == nil {
+		return -1
+	}
+	return len(g.edges[node])
+}
+
+// Degree - возвращает степень вершины. Для орграфа это сумма полустепеней захода и исхода,
+// в которой петля считается один раз, для неориентированного графа - количество инцидентных ребер.
+// Если вершины нет, возвращает -1
+func (g *Graph) Degree(value string) int {
+	node := g.getRefOfNode(value)
+	if node == nil {
+		return -1
+	}
+	if !g.is_oriented {
+		return g.OutDegree(value)
+	}
+	count := g.InDegree(value) + g.OutDegree(value)
+	// Петля попала в обе полустепени
+	if _, ok := g.edges[node][node]; ok {
+		count--
+	}
+	return count
+}
+
+// NodesWithDegree - возвращает отсортированный список вершин, степень которых (в смысле Degree) равна d
+func (g *Graph) NodesWithDegree(d int) []string {
+	result := []string{}
+	for _, value := range g.nodeValues() {
+		if g.Degree(value) == d {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// DegreeSequence - возвращает степени всех вершин (в смысле Degree), отсортированные по невозрастанию
+func (g *Graph) DegreeSequence() []int {
+	result := make([]int, 0, len(g.edges))
+	for node := range g.edges {
+		result = append(result, g.Degree(node.toString()))
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(result)))
+	return result
+}
+
+// InWeightedDegree - возвращает суммарный вес входящих дуг вершины (петля учитывается один раз,
+// параллельные связи мультиграфа - все, для невзвешенного графа вес связи равен 1).
+// Если вершины нет, возвращает 0
+func (g *Graph) InWeightedDegree(value string) int {
+	node := g.getRefOfNode(value)
+	if node == nil {
+		return 0
+	}
+	sum := 0
+	for key := range g.edges {
+		for _, w := range g.weightsBetween(key, node) {
+			sum += g.cost(w)
+		}
+	}
+	return sum
+}
+
+// OutWeightedDegree - возвращает суммарный вес исходящих дуг вершины (петля учитывается один раз,
+// параллельные связи мультиграфа - все, для невзвешенного графа вес связи равен 1).
+// Если вершины нет, возвращает 0
+func (g *Graph) OutWeightedDegree(value string) int {
+	node := g.getRefOfNode(value)
+	if node == nil {
+		return 0
+	}
+	sum := 0
+	for next := range g.edges[node] {
+		for _, w := range g.weightsBetween(node, next) {
+			sum += g.cost(w)
+		}
+	}
+	return sum
+}
+
+// WeightedDegree - возвращает суммарный вес связей, инцидентных вершине (сила вершины), петля учитывается один раз.
+// Для неориентированного графа совпадает с OutWeightedDegree. Если вершины нет, возвращает 0
+func (g *Graph) WeightedDegree(value string) int {
+	node := g.getRefOfNode(value)
+	if node == nil {
+		return 0
+	}
+	if !g.is_oriented {
+		return g.OutWeightedDegree(value)
+	}
+	sum := g.InWeightedDegree(value) + g.OutWeightedDegree(value)
+	// Петля попала в обе суммы
+	for _, w := range g.weightsBetween(node, node) {
+		sum -= g.cost(w)
+	}
+	return sum
+}
+
+// validateNode - проверяет вершину графа на существование
+func validateNode(g *Graph, value string) error {
+	node := g.getRefOfNode(value)
+	if node == nil {
+		return errors.New("Вершина " + value + " не существует в графе!")
+	}
+	return nil
+}
@@ -0,0 +1,1077 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// benchmarkEdges - возвращает n связей между 10000 вершинами
+func benchmarkEdges(n int) []Edge {
+	edges := make([]Edge, n)
+	for i := range edges {
+		edges[i] = Edge{strconv.Itoa(i % 10000), strconv.Itoa(i * 7 % 10000), i % 100}
+	}
+	return edges
+}
+
+// mustGraph - строит граф по списку связей и останавливает тест при ошибке
+func mustGraph(t testing.TB, oriented, weighted bool, edges [][3]string) *Graph {
+	t.Helper()
+	g, err := NewGraphFromEdges(oriented, weighted, edges)
+	if err != nil {
+		t.Fatalf("NewGraphFromEdges() error = %v", err)
+	}
+	return g
+}
+
+func TestAddEdges(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		loops    bool
+		edges    []Edge
+		wantErr  bool
+		want     int
+	}{
+		{"неориентированный", false, true, []Edge{{"a", "b", 3}, {"b", "c", 4}, {"c", "a", 1}}, false, 3},
+		{"ориентированный", true, true, []Edge{{"a", "b", 3}, {"b", "a", 4}}, false, 2},
+		{"петля разрешена", true, true, []Edge{{"a", "a", 1}}, false, 1},
+		{"петля запрещена", true, false, []Edge{{"a", "b", 1}, {"c", "c", 1}}, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewEmptyGraph()
+			g.is_oriented = tt.oriented
+			g.AllowSelfLoops(tt.loops)
+			err := g.AddEdges(tt.edges)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddEdges() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := len(g.Edges()); got != tt.want {
+				t.Errorf("len(Edges()) = %d, want %d", got, tt.want)
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		})
+	}
+}
+
+func TestNewGraphFromFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"корректный файл", "oriented\nsuspended\na b 1\n", false},
+		{"пустой файл", "", true},
+		{"нет строки взвешенности", "oriented\n", true},
+		{"неправильная ориентация", "directed\nsuspended\n", true},
+		{"неправильная взвешенность", "oriented\nweighted\n", true},
+		{"нечисловой вес", "oriented\nsuspended\na b x\n", true},
+		{"не хватает значений", "oriented\nsuspended\na b\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "graph.txt")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			g, err := NewGraphFromFile(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewGraphFromFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if g == nil {
+				t.Fatal("NewGraphFromFile() вернул nil вместо графа")
+			}
+		})
+	}
+	if _, err := NewGraphFromFile(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("NewGraphFromFile() для отсутствующего файла должен вернуть ошибку")
+	}
+}
+
+func TestDegrees(t *testing.T) {
+	tests := []struct {
+		name                  string
+		oriented              bool
+		edges                 [][3]string
+		node                  string
+		wantIn, wantOut, want int
+	}{
+		{"петля орграфа", true, [][3]string{{"a", "a"}, {"a", "b"}, {"c", "a"}}, "a", 2, 2, 3},
+		{"только петля", true, [][3]string{{"a", "a"}}, "a", 1, 1, 1},
+		{"сток орграфа", true, [][3]string{{"a", "a"}, {"a", "b"}, {"c", "a"}}, "b", 1, 0, 1},
+		{"петля неориентированного графа", false, [][3]string{{"a", "a"}, {"a", "b"}}, "a", 2, 2, 2},
+		{"нет вершины", true, [][3]string{{"a", "b"}}, "x", -1, -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, false, tt.edges)
+			if got := g.InDegree(tt.node); got != tt.wantIn {
+				t.Errorf("InDegree() = %d, want %d", got, tt.wantIn)
+			}
+			if got := g.OutDegree(tt.node); got != tt.wantOut {
+				t.Errorf("OutDegree() = %d, want %d", got, tt.wantOut)
+			}
+			if got := g.Degree(tt.node); got != tt.want {
+				t.Errorf("Degree() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloydLargeWeights(t *testing.T) {
+	g := mustGraph(t, true, true, [][3]string{{"a", "b", "20000"}, {"b", "c", "30000"}, {"a", "c", "60000"}, {"c", "d", "15000"}})
+	dist, _ := g.Floyd()
+	tests := []struct {
+		from, to string
+		want     int
+	}{
+		{"a", "b", 20000},
+		{"a", "c", 50000},
+		{"a", "d", 65000},
+		{"b", "d", 45000},
+	}
+	for _, tt := range tests {
+		if got, ok := dist[tt.from][tt.to]; !ok || got != tt.want {
+			t.Errorf("dist[%s][%s] = %d, %v, want %d", tt.from, tt.to, got, ok, tt.want)
+		}
+	}
+	if _, ok := dist["d"]["a"]; ok {
+		t.Errorf("dist[d][a] = %d, недостижимая пара должна отсутствовать", dist["d"]["a"])
+	}
+}
+
+func TestFloyd(t *testing.T) {
+	g := mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"a", "c"}})
+	g.AddNode("e")
+	dist, pred := g.Floyd()
+	tests := []struct {
+		from, to string
+		want     int
+		wantPath []string
+		ok       bool
+	}{
+		{"a", "a", 0, []string{"a"}, true},
+		{"a", "b", 1, []string{"a", "b"}, true},
+		{"a", "d", 2, []string{"a", "c", "d"}, true},
+		{"b", "d", 2, []string{"b", "c", "d"}, true},
+		{"d", "a", 0, nil, false},
+		{"a", "e", 0, nil, false},
+		{"e", "e", 0, []string{"e"}, true},
+	}
+	for _, tt := range tests {
+		got, ok := dist[tt.from][tt.to]
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("dist[%s][%s] = %d, %v, want %d, %v", tt.from, tt.to, got, ok, tt.want, tt.ok)
+		}
+		if !ok {
+			if _, has := pred[tt.from][tt.to]; has {
+				t.Errorf("pred[%s][%s] для недостижимой пары", tt.from, tt.to)
+			}
+			continue
+		}
+		// Восстанавливаем путь по предкам с конца
+		path := []string{tt.to}
+		for v := tt.to; v != tt.from; v = pred[tt.from][v] {
+			path = append([]string{pred[tt.from][v]}, path...)
+		}
+		if !reflect.DeepEqual(path, tt.wantPath) {
+			t.Errorf("путь %s - %s = %v, want %v", tt.from, tt.to, path, tt.wantPath)
+		}
+	}
+}
+
+func TestFloydVertexNames(t *testing.T) {
+	// Значения вершин, совпадающие с числами, не должны приниматься за служебные отметки
+	g := mustGraph(t, true, true, [][3]string{{"a", "-1", "2"}, {"-1", "b", "3"}, {"0", "a", "1"}})
+	dist, pred := g.Floyd()
+	tests := []struct {
+		from, to string
+		want     int
+		wantPred string
+		ok       bool
+	}{
+		{"a", "-1", 2, "a", true},
+		{"a", "b", 5, "-1", true},
+		{"0", "b", 6, "-1", true},
+		{"0", "-1", 3, "a", true},
+		{"b", "a", 0, "", false},
+		{"-1", "0", 0, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := dist[tt.from][tt.to]
+		if ok != tt.ok || got != tt.want || pred[tt.from][tt.to] != tt.wantPred {
+			t.Errorf("dist[%s][%s], pred = %d, %v, %q, want %d, %v, %q",
+				tt.from, tt.to, got, ok, pred[tt.from][tt.to], tt.want, tt.ok, tt.wantPred)
+		}
+	}
+}
+
+func TestBellmanFord(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][3]string
+		from, to string
+		wantDist map[string]int
+		wantPath []string
+		wantLen  int
+		wantErr  error
+	}{
+		{"отрицательная дуга", [][3]string{{"a", "b", "4"}, {"a", "c", "2"}, {"c", "b", "-3"}, {"b", "d", "1"}, {"e", "a", "1"}},
+			"a", "d", map[string]int{"a": 0, "b": -1, "c": 2, "d": 0}, []string{"a", "c", "b", "d"}, 0, nil},
+		{"путь в себя", [][3]string{{"a", "b", "1"}}, "a", "a", map[string]int{"a": 0, "b": 1}, []string{"a"}, 0, nil},
+		{"отрицательный цикл", [][3]string{{"a", "b", "1"}, {"b", "c", "-2"}, {"c", "b", "1"}}, "a", "c", nil, nil, 0, ErrNegativeCycle},
+		{"нет вершины", [][3]string{{"a", "b", "1"}}, "x", "b", nil, nil, 0, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, true, true, tt.edges)
+			dist, _, err := g.BellmanFord(tt.from)
+			checkErr(t, "BellmanFord()", err, tt.wantErr)
+			if !reflect.DeepEqual(dist, tt.wantDist) {
+				t.Errorf("BellmanFord() = %v, want %v", dist, tt.wantDist)
+			}
+			path, length, err := g.ShortestPathBF(tt.from, tt.to)
+			checkErr(t, "ShortestPathBF()", err, tt.wantErr)
+			if !reflect.DeepEqual(path, tt.wantPath) || length != tt.wantLen {
+				t.Errorf("ShortestPathBF() = %v, %d, want %v, %d", path, length, tt.wantPath, tt.wantLen)
+			}
+		})
+	}
+	g := mustGraph(t, true, true, [][3]string{{"a", "b", "1"}, {"c", "a", "1"}})
+	if _, _, err := g.ShortestPathBF("a", "c"); err == nil {
+		t.Error("ShortestPathBF() для недостижимой вершины должен вернуть ошибку")
+	}
+}
+
+func TestRemoveOddDegreeNodes(t *testing.T) {
+	tests := []struct {
+		name              string
+		g                 *Graph
+		wantOnce, wantFix []string
+	}{
+		{"путь", NewPathGraph(4), []string{"1", "2"}, []string{}},
+		{"треугольник с хвостом", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"e", "e"}}),
+			[]string{"a", "b"}, []string{}},
+		{"остается изолированная вершина", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"a", "x"}, {"x", "y"}}),
+			[]string{"b", "c", "x"}, []string{"x"}},
+		{"цикл", NewCycleGraph(4), []string{"0", "1", "2", "3"}, []string{"0", "1", "2", "3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.RemoveOddDegreeNodes(false).nodeValues(); !reflect.DeepEqual(got, tt.wantOnce) {
+				t.Errorf("RemoveOddDegreeNodes(false) = %v, want %v", got, tt.wantOnce)
+			}
+			if got := tt.g.GetNewGraphWithoutOddNodes().nodeValues(); !reflect.DeepEqual(got, tt.wantOnce) {
+				t.Errorf("GetNewGraphWithoutOddNodes() = %v, want %v", got, tt.wantOnce)
+			}
+			if got := tt.g.RemoveOddDegreeNodes(true).nodeValues(); !reflect.DeepEqual(got, tt.wantFix) {
+				t.Errorf("RemoveOddDegreeNodes(true) = %v, want %v", got, tt.wantFix)
+			}
+		})
+	}
+}
+
+func TestDFSOrderIterative(t *testing.T) {
+	tests := []struct {
+		name  string
+		g     *Graph
+		start string
+	}{
+		{"решетка", NewGridGraph(4, 5), "0,0"},
+		{"цикл", NewCycleGraph(8), "3"},
+		{"орграф с недостижимыми вершинами", mustGraph(t, true, false, [][3]string{{"a", "c"}, {"a", "b"}, {"b", "d"}, {"d", "a"}, {"e", "a"}}), "a"},
+		{"случайный орграф", NewRandomGraph(60, 0.05, true, false, rand.New(rand.NewSource(3))), "0"},
+		{"изолированная вершина", NewPathGraph(1), "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.g.DFSOrder(tt.start)
+			if err != nil {
+				t.Fatalf("DFSOrder() error = %v", err)
+			}
+			if got := tt.g.DFSOrderIterative(tt.start); !reflect.DeepEqual(got, want) {
+				t.Errorf("DFSOrderIterative() = %v, want %v", got, want)
+			}
+		})
+	}
+	if got := NewPathGraph(2).DFSOrderIterative("x"); got != nil {
+		t.Errorf("DFSOrderIterative() для отсутствующей вершины = %v, want nil", got)
+	}
+}
+
+func TestDFSOrderIterativeDeepChain(t *testing.T) {
+	// Цепочка такой длины требует рекурсии глубиной в сотни тысяч вызовов,
+	// итеративный обход использует только явный стек
+	const n = 100000
+	edges := make([]Edge, n-1)
+	for i := range edges {
+		edges[i] = Edge{strconv.Itoa(i), strconv.Itoa(i + 1), -1}
+	}
+	g := NewEmptyGraph()
+	g.AddEdges(edges)
+	order := g.DFSOrderIterative("0")
+	if len(order) != n {
+		t.Fatalf("len(DFSOrderIterative()) = %d, want %d", len(order), n)
+	}
+	for i, value := range order {
+		if value != strconv.Itoa(i) {
+			t.Fatalf("DFSOrderIterative()[%d] = %s, want %d", i, value, i)
+		}
+	}
+}
+
+func TestBFSLevels(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		edges    [][3]string
+		isolated []string
+		start    string
+		want     map[string]int
+	}{
+		{"веса не учитываются", false, [][3]string{{"a", "b", "10"}, {"b", "c", "10"}, {"a", "c", "100"}, {"c", "d", "1"}}, nil, "a",
+			map[string]int{"a": 0, "b": 1, "c": 1, "d": 2}},
+		{"недостижимые вершины пропускаются", false, [][3]string{{"a", "b", "1"}, {"c", "d", "1"}}, []string{"z"}, "a",
+			map[string]int{"a": 0, "b": 1}},
+		{"орграф учитывает направление", true, [][3]string{{"a", "b", "1"}, {"b", "c", "1"}, {"d", "a", "1"}}, nil, "b",
+			map[string]int{"b": 0, "c": 1}},
+		{"изолированная вершина", false, [][3]string{{"a", "b", "1"}}, []string{"z"}, "z", map[string]int{"z": 0}},
+		{"нет вершины", false, [][3]string{{"a", "b", "1"}}, nil, "x", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, true, tt.edges)
+			for _, v := range tt.isolated {
+				g.AddNode(v)
+			}
+			if got := g.BFSLevels(tt.start); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BFSLevels(%s) = %v, want %v", tt.start, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddEdgeIfAbsent(t *testing.T) {
+	tests := []struct {
+		name       string
+		oriented   bool
+		from, to   string
+		want       bool
+		wantWeight int
+	}{
+		{"новая дуга", true, "b", "a", true, 7},
+		{"существующая дуга", true, "a", "b", false, 3},
+		{"существующее ребро в обратном направлении", false, "b", "a", false, 3},
+		{"новая вершина", false, "a", "z", true, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, true, [][3]string{{"a", "b", "3"}})
+			if got := g.AddEdgeIfAbsent(tt.from, tt.to, 7); got != tt.want {
+				t.Errorf("AddEdgeIfAbsent() = %v, want %v", got, tt.want)
+			}
+			if w, _ := g.edgeWeight(tt.from, tt.to); w != tt.wantWeight {
+				t.Errorf("вес %s - %s = %d, want %d", tt.from, tt.to, w, tt.wantWeight)
+			}
+		})
+	}
+}
+
+func TestNewGraphFromReaderDuplicates(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		strict     bool
+		wantErr    error
+		wantWeight int
+	}{
+		{"повтор перезаписывает вес", "oriented\nsuspended\na b 1\na b 5\n", false, nil, 5},
+		{"повтор запрещен", "oriented\nsuspended\na b 1\na b 5\n", true, ErrDuplicateEdge, 0},
+		{"одинаковый повтор ребра", "unoriented\nsuspended\na b 2\nb a 2\n", false, nil, 2},
+		{"одинаковый повтор ребра запрещен", "unoriented\nsuspended\na b 2\nb a 2\n", true, ErrDuplicateEdge, 0},
+		{"ребро с разными весами", "unoriented\nsuspended\na b 2\n\nb a 3\n", false, ErrConflictingEdge, 0},
+		{"без повторов", "oriented   \n  suspended\n\na   b 4\n", true, nil, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			read := NewGraphFromReader
+			if tt.strict {
+				read = NewGraphFromReaderStrict
+			}
+			g, err := read(strings.NewReader(tt.input))
+			checkErr(t, "NewGraphFromReader()", err, tt.wantErr)
+			if err != nil {
+				return
+			}
+			if w, ok := g.edgeWeight("a", "b"); !ok || w != tt.wantWeight {
+				t.Errorf("вес a - b = %d, %v, want %d", w, ok, tt.wantWeight)
+			}
+		})
+	}
+}
+
+func TestNewCopiedGraph(t *testing.T) {
+	weighted := mustGraph(t, false, true, [][3]string{{"a", "b", "3"}, {"b", "c", "0"}})
+	weighted.AddNode("z")
+	weighted.SetNodeAttr("a", "color", "red")
+	weighted.AllowSelfLoops(false)
+	directed := mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "a"}, {"c", "c"}})
+	directed.AddNode("y")
+	multigraph := NewEmptyMultigraph()
+	multigraph.AddEdge("a", "b", 4)
+	multigraph.AddEdge("a", "b", 2)
+	multigraph.AddNode("z")
+	isolated := NewEmptyGraph()
+	isolated.AddNode("x")
+	isolated.AddNode("y")
+
+	tests := []struct {
+		name string
+		g    *Graph
+	}{
+		{"изолированная вершина и атрибуты", weighted},
+		{"орграф с петлей", directed},
+		{"мультиграф", multigraph},
+		{"только изолированные вершины", isolated},
+		{"пустой граф", NewEmptyGraph()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCopiedGraph(tt.g)
+			if !c.Equal(tt.g) {
+				t.Fatalf("NewCopiedGraph() = %v, want %v", c.Edges(), tt.g.Edges())
+			}
+			if !reflect.DeepEqual(c.nodeValues(), tt.g.nodeValues()) {
+				t.Errorf("вершины копии = %v, want %v", c.nodeValues(), tt.g.nodeValues())
+			}
+			if c.no_self_loops != tt.g.no_self_loops {
+				t.Errorf("no_self_loops = %v, want %v", c.no_self_loops, tt.g.no_self_loops)
+			}
+			for _, value := range tt.g.nodeValues() {
+				want, _ := tt.g.NodeAttr(value, "color")
+				if got, _ := c.NodeAttr(value, "color"); got != want {
+					t.Errorf("NodeAttr(%s) = %q, want %q", value, got, want)
+				}
+			}
+			if errs := c.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+			// Копия независима от исходного графа
+			before := tt.g.Edges()
+			c.AddNode("новая")
+			c.AddEdge("новая", "другая", 1)
+			if tt.g.HasNode("новая") || !reflect.DeepEqual(tt.g.Edges(), before) {
+				t.Error("изменение копии затронуло исходный граф")
+			}
+		})
+	}
+}
+
+func TestMultigraph(t *testing.T) {
+	tests := []struct {
+		name         string
+		multigraph   bool
+		wantParallel []int
+		wantDistance int
+		wantFlow     int
+	}{
+		{"мультиграф", true, []int{4, 2, 7}, 2 + 1, 1},
+		{"обычный граф перезаписывает вес", false, []int{7}, 7 + 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewEmptyGraph()
+			if tt.multigraph {
+				g = NewEmptyMultigraph()
+			}
+			for _, w := range []int{4, 2, 7} {
+				g.AddEdge("a", "b", w)
+			}
+			g.AddEdge("b", "c", 1)
+			if got := g.ParallelEdges("a", "b"); !reflect.DeepEqual(got, tt.wantParallel) {
+				t.Errorf("ParallelEdges() = %v, want %v", got, tt.wantParallel)
+			}
+			// Кратчайший путь идет по параллельной связи минимального веса
+			if distances, err := g.Deikstra("a", false); err != nil || distances["c"] != tt.wantDistance {
+				t.Errorf("Deikstra()[c] = %d, %v, want %d", distances["c"], err, tt.wantDistance)
+			}
+			// Пропускные способности параллельных связей складываются
+			if flow, err := g.MaxFlow("a", "b"); err != nil || flow != sum(tt.wantParallel) {
+				t.Errorf("MaxFlow(a, b) = %d, %v, want %d", flow, err, sum(tt.wantParallel))
+			}
+			if flow, err := g.MaxFlow("a", "c"); err != nil || flow != tt.wantFlow {
+				t.Errorf("MaxFlow(a, c) = %d, %v, want %d", flow, err, tt.wantFlow)
+			}
+			if g.OutDegree("a") != 1 || g.InDegree("b") != 1 {
+				t.Errorf("OutDegree(a) = %d, InDegree(b) = %d, want 1, 1", g.OutDegree("a"), g.InDegree("b"))
+			}
+			if removed, err := g.RemoveEdge("a", "b"); !removed || err != nil || len(g.ParallelEdges("a", "b")) != 0 {
+				t.Errorf("RemoveEdge() = %v, %v, осталось %v", removed, err, g.ParallelEdges("a", "b"))
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		})
+	}
+}
+
+// sum - возвращает сумму чисел
+func sum(values []int) int {
+	result := 0
+	for _, v := range values {
+		result += v
+	}
+	return result
+}
+
+func TestRemoveNode(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+	}{
+		{"неориентированный граф", false},
+		{"орграф", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Большой случайный граф и вершина, связанная со всеми остальными в обоих направлениях и петлей
+			g := NewRandomGraph(2000, 0.002, tt.oriented, false, rand.New(rand.NewSource(5)))
+			for _, value := range g.nodeValues() {
+				g.AddEdge("hub", value, -1)
+				g.AddEdge(value, "hub", -1)
+			}
+			g.AddEdge("hub", "hub", -1)
+			g.SetNodeAttr("hub", "role", "center")
+			edges := len(g.Edges())
+
+			if err := g.RemoveNode("hub"); err != nil {
+				t.Fatalf("RemoveNode() error = %v", err)
+			}
+			if g.HasNode("hub") {
+				t.Error("вершина hub осталась в графе")
+			}
+			for node, v := range g.edges {
+				for next := range v {
+					if next.toString() == "hub" {
+						t.Fatalf("у вершины %s осталась связь с удаленной вершиной", node.toString())
+					}
+				}
+			}
+			removed := 2*2000 + 1
+			if !tt.oriented {
+				removed = 2000 + 1
+			}
+			if got := len(g.Edges()); got != edges-removed {
+				t.Errorf("len(Edges()) = %d, want %d", got, edges-removed)
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+			if err := g.RemoveNode("hub"); err == nil {
+				t.Error("повторный RemoveNode() должен вернуть ошибку")
+			}
+		})
+	}
+}
+
+func TestRemoveEdge(t *testing.T) {
+	tests := []struct {
+		name      string
+		oriented  bool
+		from, to  string
+		want      bool
+		wantErr   bool
+		wantEdges int
+	}{
+		{"дуга", true, "a", "b", true, false, 1},
+		{"дуга в обратном направлении", true, "b", "a", false, false, 2},
+		{"ребро в обратном направлении", false, "b", "a", true, false, 1},
+		{"петля", false, "c", "c", true, false, 1},
+		{"нет связи", false, "a", "c", false, false, 2},
+		{"нет вершины", false, "a", "x", false, true, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, false, [][3]string{{"a", "b"}, {"c", "c"}})
+			var buf bytes.Buffer
+			g.SetOutput(&buf)
+			removed, err := g.RemoveEdge(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RemoveEdge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if removed != tt.want {
+				t.Errorf("RemoveEdge() = %v, want %v", removed, tt.want)
+			}
+			if got := len(g.Edges()); got != tt.wantEdges {
+				t.Errorf("len(Edges()) = %d, want %d", got, tt.wantEdges)
+			}
+			if buf.Len() != 0 {
+				t.Errorf("RemoveEdge() вывел %q", buf.String())
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		})
+	}
+}
+
+func TestSetWeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		weighted bool
+		from, to string
+		want     map[[2]string]int // ожидаемые веса дуг после замены
+		wantErr  error
+	}{
+		{"ребро в обоих направлениях", false, true, "b", "a", map[[2]string]int{{"a", "b"}: 10, {"b", "a"}: 10, {"b", "c"}: 2}, nil},
+		{"дуга в одном направлении", true, true, "a", "b", map[[2]string]int{{"a", "b"}: 10, {"b", "a"}: 4, {"b", "c"}: 2}, nil},
+		{"петля", false, true, "c", "c", map[[2]string]int{{"c", "c"}: 10, {"a", "b"}: 4}, nil},
+		{"нет связи", false, true, "a", "c", map[[2]string]int{{"a", "b"}: 4}, ErrNoEdge},
+		{"нет дуги против направления", true, true, "c", "b", map[[2]string]int{{"b", "c"}: 2}, ErrNoEdge},
+		{"нет вершины", false, true, "a", "x", map[[2]string]int{{"a", "b"}: 4}, errAny},
+		{"невзвешенный граф", false, false, "a", "b", map[[2]string]int{{"a", "b"}: -1}, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, tt.weighted, [][3]string{{"a", "b", "3"}, {"b", "a", "4"}, {"b", "c", "2"}, {"c", "c", "1"}})
+			checkErr(t, "SetWeight()", g.SetWeight(tt.from, tt.to, 10), tt.wantErr)
+			for e, want := range tt.want {
+				if got, ok := g.edgeWeight(e[0], e[1]); !ok || got != want {
+					t.Errorf("вес %s - %s = %d, %v, want %d", e[0], e[1], got, ok, want)
+				}
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		})
+	}
+	// В мультиграфе заменяются веса всех параллельных связей
+	g := NewEmptyMultigraph()
+	g.AddEdge("a", "b", 3)
+	g.AddEdge("a", "b", 5)
+	if err := g.SetWeight("a", "b", 7); err != nil || !reflect.DeepEqual(g.ParallelEdges("a", "b"), []int{7, 7}) {
+		t.Errorf("SetWeight() в мультиграфе: %v, ParallelEdges() = %v, want [7 7]", err, g.ParallelEdges("a", "b"))
+	}
+}
+
+func TestSelfLoops(t *testing.T) {
+	// Путь a - b - c с петлей у a
+	newLooped := func(oriented bool) *Graph {
+		return mustGraph(t, oriented, true, [][3]string{{"a", "a", "5"}, {"a", "b", "1"}, {"b", "c", "2"}})
+	}
+	tests := []struct {
+		name  string
+		check func(t *testing.T, g *Graph)
+	}{
+		{"HasSelfLoop и SelfLoops", func(t *testing.T, g *Graph) {
+			if !g.HasSelfLoop("a") || g.HasSelfLoop("b") || g.HasSelfLoop("x") {
+				t.Error("HasSelfLoop() находит петли неверно")
+			}
+			if got := g.SelfLoops(); !reflect.DeepEqual(got, []string{"a"}) {
+				t.Errorf("SelfLoops() = %v, want [a]", got)
+			}
+		}},
+		{"степень учитывает петлю один раз", func(t *testing.T, g *Graph) {
+			if got := g.Degree("a"); got != 2 {
+				t.Errorf("Degree(a) = %d, want 2", got)
+			}
+		}},
+		{"Floyd, Deikstra и BellmanFord дают 0 до самой вершины", func(t *testing.T, g *Graph) {
+			dist, _ := g.Floyd()
+			deikstra, err := g.Deikstra("a", false)
+			if err != nil {
+				t.Fatalf("Deikstra() error = %v", err)
+			}
+			bellman, _, err := g.BellmanFord("a")
+			if err != nil {
+				t.Fatalf("BellmanFord() error = %v", err)
+			}
+			if dist["a"]["a"] != 0 || deikstra["a"] != 0 || bellman["a"] != 0 || dist["a"]["c"] != 3 {
+				t.Errorf("расстояния a - a: %d, %d, %d, a - c: %d", dist["a"]["a"], deikstra["a"], bellman["a"], dist["a"]["c"])
+			}
+		}},
+		{"обходы пропускают петлю", func(t *testing.T, g *Graph) {
+			bfs, _ := g.BFSOrder("a")
+			dfs, _ := g.DFSOrder("a")
+			want := []string{"a", "b", "c"}
+			if !reflect.DeepEqual(bfs, want) || !reflect.DeepEqual(dfs, want) || !reflect.DeepEqual(g.DFSOrderIterative("a"), want) {
+				t.Errorf("BFSOrder() = %v, DFSOrder() = %v, want %v", bfs, dfs, want)
+			}
+		}},
+		{"петля - цикл для проверки на дерево", func(t *testing.T, g *Graph) {
+			var buf bytes.Buffer
+			g.SetOutput(&buf)
+			g.IsGraphTreeOrForest()
+			if !strings.Contains(buf.String(), "не является ни деревом, ни лесом") {
+				t.Errorf("IsGraphTreeOrForest() = %q", buf.String())
+			}
+			g.RemoveEdge("a", "a")
+			buf.Reset()
+			g.IsGraphTreeOrForest()
+			if !strings.Contains(buf.String(), "является деревом") {
+				t.Errorf("IsGraphTreeOrForest() без петли = %q", buf.String())
+			}
+		}},
+		{"Prim не включает петлю в дерево", func(t *testing.T, g *Graph) {
+			tree, total, err := g.Prim("a")
+			if err != nil || total != 3 || tree.HasSelfLoop("a") {
+				t.Errorf("Prim() = %v, %d, %v, want вес 3 без петли", tree.Edges(), total, err)
+			}
+		}},
+		{"MaxFlow не зависит от петли", func(t *testing.T, g *Graph) {
+			if flow, err := g.MaxFlow("a", "c"); err != nil || flow != 1 {
+				t.Errorf("MaxFlow() = %d, %v, want 1", flow, err)
+			}
+		}},
+		{"запрет петель", func(t *testing.T, g *Graph) {
+			g.AllowSelfLoops(false)
+			if err := g.AddEdge("b", "b", 1); !errors.Is(err, ErrSelfLoop) {
+				t.Errorf("AddEdge(b, b) error = %v, want ErrSelfLoop", err)
+			}
+			if err := g.AddEdges([]Edge{{"c", "d", 1}, {"d", "d", 1}}); !errors.Is(err, ErrSelfLoop) || g.HasNode("d") {
+				t.Errorf("AddEdges() error = %v, want ErrSelfLoop без изменений", err)
+			}
+			if !g.HasSelfLoop("a") {
+				t.Error("существующая петля удалена при запрете")
+			}
+			g.AllowSelfLoops(true)
+			if err := g.AddEdge("b", "b", 1); err != nil {
+				t.Errorf("AddEdge(b, b) после разрешения error = %v", err)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, newLooped(false))
+		})
+	}
+	if newLooped(true).IsDAG() {
+		t.Error("IsDAG() для орграфа с петлей = true")
+	}
+	if got := newLooped(true).Degree("a"); got != 2 {
+		t.Errorf("Degree(a) в орграфе = %d, want 2", got)
+	}
+}
+
+func TestClearAndRemoveAllEdges(t *testing.T) {
+	tests := []struct {
+		name       string
+		oriented   bool
+		weighted   bool
+		multigraph bool
+	}{
+		{"ориентированный взвешенный", true, true, false},
+		{"неориентированный невзвешенный", false, false, false},
+		{"мультиграф", true, true, true},
+	}
+	for _, tt := range tests {
+		build := func() *Graph {
+			g := NewEmptyGraph()
+			if tt.multigraph {
+				g = NewEmptyMultigraph()
+			}
+			g.is_oriented, g.is_suspended = tt.oriented, tt.weighted
+			for _, e := range []Edge{{"a", "b", 3}, {"a", "b", 4}, {"b", "c", 1}, {"c", "c", 2}} {
+				g.AddEdge(e.From, e.To, e.Weight)
+			}
+			g.AddNode("z")
+			g.SetNodeAttr("a", "color", "red")
+			g.AllowSelfLoops(false)
+			return g
+		}
+		checkFlags := func(t *testing.T, g *Graph) {
+			if g.is_oriented != tt.oriented || g.is_suspended != tt.weighted || g.is_multigraph != tt.multigraph || !g.no_self_loops {
+				t.Errorf("флаги изменились: oriented %v, suspended %v, multigraph %v, no_self_loops %v",
+					g.is_oriented, g.is_suspended, g.is_multigraph, g.no_self_loops)
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		}
+		t.Run(tt.name+"/RemoveAllEdges", func(t *testing.T) {
+			g := build()
+			g.RemoveAllEdges()
+			checkFlags(t, g)
+			if got := g.nodeValues(); !reflect.DeepEqual(got, []string{"a", "b", "c", "z"}) {
+				t.Errorf("вершины = %v, want [a b c z]", got)
+			}
+			if len(g.Edges()) != 0 {
+				t.Errorf("Edges() = %v, want []", g.Edges())
+			}
+			for _, value := range g.nodeValues() {
+				if d := g.InDegree(value); d != 0 {
+					t.Errorf("InDegree(%s) = %d, want 0", value, d)
+				}
+			}
+			if color, ok := g.NodeAttr("a", "color"); !ok || color != "red" {
+				t.Errorf("NodeAttr(a) = %q, %v, want red", color, ok)
+			}
+			g.AddEdge("a", "z", 5)
+			if len(g.Edges()) != 1 || g.InDegree("z") != 1 {
+				t.Errorf("после добавления связи Edges() = %v, InDegree(z) = %d", g.Edges(), g.InDegree("z"))
+			}
+			checkFlags(t, g)
+		})
+		t.Run(tt.name+"/Clear", func(t *testing.T) {
+			g := build()
+			g.Clear()
+			checkFlags(t, g)
+			if len(g.nodeValues()) != 0 || len(g.index) != 0 || len(g.in_degree) != 0 || len(g.parallel) != 0 {
+				t.Errorf("после Clear() вершины %v, index %d, in_degree %d, parallel %d",
+					g.nodeValues(), len(g.index), len(g.in_degree), len(g.parallel))
+			}
+			if _, ok := g.NodeAttr("a", "color"); ok || g.HasNode("a") {
+				t.Error("после Clear() осталась вершина a или ее атрибут")
+			}
+			g.AddEdge("a", "b", 5)
+			if !reflect.DeepEqual(g.nodeValues(), []string{"a", "b"}) || g.InDegree("b") != 1 {
+				t.Errorf("после повторного заполнения вершины %v, InDegree(b) = %d", g.nodeValues(), g.InDegree("b"))
+			}
+			checkFlags(t, g)
+		})
+	}
+}
+
+func TestPrintDataInFileRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		weighted bool
+		edges    [][3]string
+	}{
+		{"ориентированный взвешенный", true, true, [][3]string{{"a", "b", "3"}, {"b", "a", "4"}, {"b", "c", "0"}}},
+		{"неориентированный взвешенный", false, true, [][3]string{{"a", "b", "3"}, {"b", "c", "7"}, {"c", "c", "1"}}},
+		{"ориентированный невзвешенный", true, false, [][3]string{{"a", "b"}, {"b", "c"}}},
+		{"неориентированный невзвешенный", false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, tt.weighted, tt.edges)
+			path := filepath.Join(t.TempDir(), "graph.txt")
+			if err := g.PrintDataInFile(path); err != nil {
+				t.Fatalf("PrintDataInFile() error = %v", err)
+			}
+			restored, err := NewGraphFromFile(path)
+			if err != nil {
+				t.Fatalf("NewGraphFromFile() error = %v", err)
+			}
+			if !g.Equal(restored) {
+				t.Errorf("прочитанный граф %v, want %v", restored.Edges(), g.Edges())
+			}
+		})
+	}
+	if err := NewPathGraph(2).PrintDataInFile(filepath.Join(t.TempDir(), "missing", "graph.txt")); err == nil {
+		t.Error("PrintDataInFile() в несуществующий каталог должен вернуть ошибку")
+	}
+}
+
+func TestPrintDataInFileEdgeLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		edges    [][3]string
+		want     int
+	}{
+		{"неориентированный треугольник", false, [][3]string{{"a", "b", "1"}, {"b", "c", "2"}, {"c", "a", "3"}}, 3},
+		{"ребро с петлей", false, [][3]string{{"a", "b", "1"}, {"a", "a", "2"}}, 2},
+		{"встречные дуги", true, [][3]string{{"a", "b", "1"}, {"b", "a", "1"}}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "graph.txt")
+			if err := mustGraph(t, tt.oriented, true, tt.edges).PrintDataInFile(path); err != nil {
+				t.Fatalf("PrintDataInFile() error = %v", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			if got := len(lines) - 2; got != tt.want {
+				t.Errorf("записано %d строк со связями, want %d:\n%s", got, tt.want, data)
+			}
+		})
+	}
+}
+
+func TestOutput(t *testing.T) {
+	tests := []struct {
+		name  string
+		print func(g *Graph)
+		want  []string
+	}{
+		{"PrintInformationAboutGraph", (*Graph).PrintInformationAboutGraph,
+			[]string{"- Неориентированный", "- Взвешенный", "Узел: a", "Узел: c", "\t b : 2"}},
+		{"Bfs", func(g *Graph) { g.Bfs("a", true) }, []string{"Узел a\nУзел b\nУзел c\n"}},
+		{"Deikstra", func(g *Graph) { g.Deikstra("a", true) }, []string{"Минимальное расстояние от вершины: a до вершины: c равно: 4"}},
+		{"GetRadiusOfGraph", (*Graph).GetRadiusOfGraph, []string{"Радиус графа равен: 2"}},
+		{"PrintFloyd", func(g *Graph) { g.PrintFloyd(g.Floyd()) }, []string{"Кратчайшее расстояние между a и c составляет 4"}},
+		{"Bellman", func(g *Graph) { g.Bellman("a", "c", true) }, []string{"Расстояние между a и c составляет: 4", "a -> b -> c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, false, true, [][3]string{{"a", "b", "2"}, {"b", "c", "2"}})
+			var buf bytes.Buffer
+			g.SetOutput(&buf)
+			tt.print(g)
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("вывод %q не содержит %q", buf.String(), want)
+				}
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	g := mustGraph(t, true, false, [][3]string{{"a", "b"}})
+	g.WriteInfo(&buf)
+	for _, want := range []string{"- Ориентированный", "- Невзвешенный", "a:\n\t b\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("WriteInfo() = %q не содержит %q", buf.String(), want)
+		}
+	}
+}
+
+func TestDegreeCache(t *testing.T) {
+	tests := []struct {
+		name       string
+		oriented   bool
+		multigraph bool
+	}{
+		{"орграф", true, false},
+		{"неориентированный граф", false, false},
+		{"мультиграф", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewEmptyGraph()
+			if tt.multigraph {
+				g = NewEmptyMultigraph()
+			}
+			g.is_oriented = tt.oriented
+			rng := rand.New(rand.NewSource(11))
+			value := func() string { return strconv.Itoa(rng.Intn(30)) }
+			for step := 0; step < 2000; step++ {
+				switch rng.Intn(10) {
+				case 0:
+					g.RemoveNode(value())
+				case 1, 2:
+					g.RemoveEdge(value(), value())
+				case 3:
+					g.RenameNode(value(), value())
+				case 4:
+					g.MergeNodes(value(), value())
+				case 5:
+					g.AddEdgeIfAbsent(value(), value(), rng.Intn(10))
+				default:
+					g.AddEdge(value(), value(), rng.Intn(10))
+				}
+			}
+			// Полустепени, пересчитанные по спискам смежности
+			in := map[*Node]int{}
+			for _, v := range g.edges {
+				for next := range v {
+					in[next]++
+				}
+			}
+			for node, v := range g.edges {
+				value := node.toString()
+				if got := g.InDegree(value); got != in[node] {
+					t.Errorf("InDegree(%s) = %d, want %d", value, got, in[node])
+				}
+				if got := g.OutDegree(value); got != len(v) {
+					t.Errorf("OutDegree(%s) = %d, want %d", value, got, len(v))
+				}
+			}
+			if errs := g.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		})
+	}
+}
+
+func TestWeightedDegree(t *testing.T) {
+	// Взвешенная звезда с центром c, петлей у центра и дугой, входящей в центр
+	star := [][3]string{{"c", "a", "2"}, {"c", "b", "3"}, {"c", "d", "5"}, {"c", "c", "7"}, {"e", "c", "11"}}
+	tests := []struct {
+		name                  string
+		oriented, weighted    bool
+		node                  string
+		wantIn, wantOut, want int
+	}{
+		{"центр орграфа", true, true, "c", 18, 17, 28},
+		{"лист орграфа", true, true, "a", 2, 0, 2},
+		{"центр неориентированной звезды", false, true, "c", 28, 28, 28},
+		{"невзвешенная звезда", false, false, "c", 5, 5, 5},
+		{"нет вершины", true, true, "x", 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, tt.weighted, star)
+			if got := g.InWeightedDegree(tt.node); got != tt.wantIn {
+				t.Errorf("InWeightedDegree() = %d, want %d", got, tt.wantIn)
+			}
+			if got := g.OutWeightedDegree(tt.node); got != tt.wantOut {
+				t.Errorf("OutWeightedDegree() = %d, want %d", got, tt.wantOut)
+			}
+			if got := g.WeightedDegree(tt.node); got != tt.want {
+				t.Errorf("WeightedDegree() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodesWithDegree(t *testing.T) {
+	tests := []struct {
+		name         string
+		g            *Graph
+		d            int
+		want         []string
+		wantSequence []int
+	}{
+		{"звезда", starGraph(t, "a", "b", "d"), 1, []string{"a", "b", "d"}, []int{3, 1, 1, 1}},
+		{"центр звезды", starGraph(t, "a", "b", "d"), 3, []string{"c"}, []int{3, 1, 1, 1}},
+		{"путь", NewPathGraph(4), 2, []string{"1", "2"}, []int{2, 2, 1, 1}},
+		{"нет вершин такой степени", NewCycleGraph(4), 3, []string{}, []int{2, 2, 2, 2}},
+		{"петля в орграфе", mustGraph(t, true, false, [][3]string{{"a", "a"}, {"a", "b"}}), 2, []string{"a"}, []int{2, 1}},
+		{"пустой граф", NewEmptyGraph(), 0, []string{}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.NodesWithDegree(tt.d); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NodesWithDegree(%d) = %v, want %v", tt.d, got, tt.want)
+			}
+			if got := tt.g.DegreeSequence(); !reflect.DeepEqual(got, tt.wantSequence) {
+				t.Errorf("DegreeSequence() = %v, want %v", got, tt.wantSequence)
+			}
+		})
+	}
+}
+
+func BenchmarkAddEdges(b *testing.B) {
+	edges := benchmarkEdges(100000)
+	for i := 0; i < b.N; i++ {
+		NewEmptyGraph().AddEdges(edges)
+	}
+}
+
+func BenchmarkAddEdgeLoop(b *testing.B) {
+	edges := benchmarkEdges(100000)
+	for i := 0; i < b.N; i++ {
+		g := NewEmptyGraph()
+		for _, e := range edges {
+			g.AddEdge(e.From, e.To, e.Weight)
+		}
+	}
+}
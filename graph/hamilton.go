@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"errors"
+	"math"
+)
+
+/*
+
+Гамильтоновы пути и циклы:
+- HamiltonianPath - путь, проходящий через каждую вершину ровно один раз
+- TSPExact - точное решение задачи коммивояжера (алгоритм Хелда-Карпа)
+- TSPNearestNeighbor - приближенное решение задачи коммивояжера методом ближайшего соседа
+
+*/
+
+// maxNodesForHamiltonian - наибольшее количество вершин, для которого выполняется
+// точный (экспоненциальный) поиск гамильтонова пути
+const maxNodesForHamiltonian = 20
+
+// maxNodesForTSPExact - наибольшее количество вершин для точного решения задачи коммивояжера
+const maxNodesForTSPExact = 15
+
+// HamiltonianPath - поиском в глубину с возвратом находит путь, проходящий через каждую вершину ровно один раз.
+// Возвращает путь и true, если он существует, иначе nil и false. Вершины и соседи перебираются
+// в порядке значений, состояния (текущая вершина, множество пройденных), из которых путь не достраивается,
+// запоминаются и повторно не рассматриваются. Если вершин больше 20, возвращает ошибку
+func (g *Graph) HamiltonianPath() ([]string, bool, error) {
+	values := g.nodeValues()
+	if len(values) > maxNodesForHamiltonian {
+		return nil, false, errors.New("Слишком много вершин для поиска гамильтонова пути")
+	}
+	if len(values) == 0 || !g.IsWeaklyConnected() {
+		return nil, false, nil
+	}
+	nodes := make([]*Node, len(values))
+	index := make(map[*Node]int, len(values))
+	for i, value := range values {
+		nodes[i] = g.getRefOfNode(value)
+		index[nodes[i]] = i
+	}
+	full := uint32(1)<<len(nodes) - 1
+	failed := make(map[uint64]bool) // (множество пройденных, текущая вершина), из которых путь не достраивается
+	path := make([]string, 0, len(nodes))
+
+	var search func(current int, mask uint32) bool
+	search = func(current int, mask uint32) bool {
+		path = append(path, values[current])
+		if mask == full {
+			return true
+		}
+		key := uint64(mask)<<5 | uint64(current)
+		if !failed[key] {
+			for _, next := range g.sortedNeighbors(nodes[current]) {
+				i := index[next]
+				if mask&(1<<i) == 0 && search(i, mask|1<<i) {
+					return true
+				}
+			}
+			failed[key] = true
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+
+	for i := range nodes {
+		if search(i, 1<<i) {
+			return path, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// completeWeights - для полного неориентированного графа возвращает отсортированные значения вершин
+// и матрицу длин ребер между ними (для невзвешенного графа длина ребра равна 1).
+// Если граф ориентированный или не полный, возвращает ошибку
+func (g *Graph) completeWeights() ([]string, [][]int, error) {
+	if g.is_oriented {
+		return nil, nil, errors.New("Граф должен быть неориентированным")
+	}
+	values := g.nodeValues()
+	weights := make([][]int, len(values))
+	for i, value := range values {
+		weights[i] = make([]int, len(values))
+		for j, other := range values {
+			if i == j {
+				continue
+			}
+			w, ok := g.edgeWeight(value, other)
+			if !ok {
+				return nil, nil, errors.New("Граф не является полным: нет ребра " + value + " - " + other)
+			}
+			weights[i][j] = g.cost(w)
+		}
+	}
+	return values, weights, nil
+}
+
+// TSPExact - находит гамильтонов цикл минимального веса в полном неориентированном графе
+// динамическим программированием по подмножествам (алгоритм Хелда-Карпа, O(2^n * n^2)).
+// Возвращает порядок обхода вершин, начиная с наименьшей по значению (возврат в нее не повторяется),
+// и вес цикла с учетом возвращающего ребра. Если граф пуст, не полный или содержит больше 15 вершин,
+// возвращает ошибку
+func (g *Graph) TSPExact() ([]string, int, error) {
+	if len(g.edges) > maxNodesForTSPExact {
+		return nil, 0, errors.New("Слишком много вершин для точного решения задачи коммивояжера")
+	}
+	values, weights, err := g.completeWeights()
+	if err != nil {
+		return nil, 0, err
+	}
+	n := len(values)
+	if n == 0 {
+		return nil, 0, errors.New("Граф пуст")
+	}
+	if n == 1 {
+		return values, 0, nil
+	}
+
+	// dp[mask][last] - вес кратчайшего пути из вершины 0 через вершины mask, заканчивающегося в last
+	full := 1<<n - 1
+	dp := make([][]int, full+1)
+	parent := make([][]int, full+1)
+	for mask := range dp {
+		dp[mask] = make([]int, n)
+		parent[mask] = make([]int, n)
+		for last := range dp[mask] {
+			dp[mask][last] = math.MaxInt
+		}
+	}
+	dp[1][0] = 0
+	for mask := 1; mask <= full; mask += 2 { // вершина 0 всегда входит в путь
+		for last := 0; last < n; last++ {
+			if dp[mask][last] == math.MaxInt {
+				continue
+			}
+			for next := 1; next < n; next++ {
+				if mask&(1<<next) != 0 {
+					continue
+				}
+				nextMask := mask | 1<<next
+				if d := dp[mask][last] + weights[last][next]; d < dp[nextMask][next] {
+					dp[nextMask][next] = d
+					parent[nextMask][next] = last
+				}
+			}
+		}
+	}
+
+	best, last := math.MaxInt, 0
+	for i := 1; i < n; i++ {
+		if d := dp[full][i] + weights[i][0]; d < best {
+			best, last = d, i
+		}
+	}
+	tour := make([]string, n)
+	for mask, i := full, n-1; i >= 0; i-- {
+		tour[i] = values[last]
+		mask, last = mask&^(1<<last), parent[mask][last]
+	}
+	return tour, best, nil
+}
+
+// TSPNearestNeighbor - строит гамильтонов цикл в полном неориентированном графе жадно:
+// из текущей вершины всегда переходит в ближайшую непосещенную (при равенстве - в наименьшую по значению).
+// Возвращает порядок обхода, начиная со start (возврат в нее не повторяется), и вес цикла
+// с учетом ребра из последней вершины обратно в start. Результат приближенный, его можно сравнить с TSPExact.
+// Если вершины нет или граф не полный, возвращает ошибку
+func (g *Graph) TSPNearestNeighbor(start string) ([]string, int, error) {
+	if err := validateNode(g, start); err != nil {
+		return nil, 0, err
+	}
+	values, weights, err := g.completeWeights()
+	if err != nil {
+		return nil, 0, err
+	}
+	first := 0
+	for values[first] != start {
+		first++
+	}
+	current := first
+	visited := make([]bool, len(values))
+	visited[current] = true
+	tour := []string{start}
+	total := 0
+	for len(tour) < len(values) {
+		next := -1
+		for i := range values {
+			if !visited[i] && (next == -1 || weights[current][i] < weights[current][next]) {
+				next = i
+			}
+		}
+		visited[next] = true
+		tour = append(tour, values[next])
+		total += weights[current][next]
+		current = next
+	}
+	// Возвращающее ребро (для единственной вершины current == first и его вес равен 0)
+	total += weights[current][first]
+	return tour, total, nil
+}
@@ -0,0 +1,252 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// randomCompleteGraph - полный неориентированный взвешенный граф на вершинах "0", ..., "n-1" с весами от 1 до 20
+func randomCompleteGraph(t testing.TB, n int, rng *rand.Rand) *Graph {
+	edges := [][3]string{}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			edges = append(edges, [3]string{strconv.Itoa(i), strconv.Itoa(j), strconv.Itoa(1 + rng.Intn(20))})
+		}
+	}
+	g := mustGraph(t, false, true, edges)
+	for i := 0; i < n; i++ {
+		g.AddNode(strconv.Itoa(i))
+	}
+	return g
+}
+
+// tourWeight - вес цикла, проходящего вершины tour по порядку и возвращающегося в первую
+func tourWeight(t testing.TB, g *Graph, tour []string) int {
+	total := 0
+	for i := range tour {
+		next := tour[(i+1)%len(tour)]
+		if next == tour[i] {
+			continue
+		}
+		w, ok := g.edgeWeight(tour[i], next)
+		if !ok {
+			t.Fatalf("нет ребра %s - %s в цикле %v", tour[i], next, tour)
+		}
+		total += g.cost(w)
+	}
+	return total
+}
+
+// bruteForceTSP - вес минимального гамильтонова цикла полным перебором перестановок вершин
+func bruteForceTSP(t testing.TB, g *Graph) int {
+	values := g.nodeValues()
+	best := math.MaxInt
+	var permute func(k int)
+	permute = func(k int) {
+		if k == len(values) {
+			if w := tourWeight(t, g, values); w < best {
+				best = w
+			}
+			return
+		}
+		for i := k; i < len(values); i++ {
+			values[k], values[i] = values[i], values[k]
+			permute(k + 1)
+			values[k], values[i] = values[i], values[k]
+		}
+	}
+	permute(1) // первая вершина цикла фиксирована
+	return best
+}
+
+// checkTour - проверяет, что tour проходит каждую вершину графа ровно один раз и начинается с first
+func checkTour(t *testing.T, g *Graph, tour []string, first string) {
+	t.Helper()
+	if len(tour) == 0 || tour[0] != first {
+		t.Errorf("цикл %v должен начинаться с %s", tour, first)
+	}
+	seen := map[string]bool{}
+	for _, v := range tour {
+		if seen[v] || !g.HasNode(v) {
+			t.Errorf("цикл %v проходит %s повторно или вне графа", tour, v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != len(g.nodeValues()) {
+		t.Errorf("цикл %v проходит не все вершины", tour)
+	}
+}
+
+func TestTSPExact(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for n := 2; n <= 6; n++ {
+		for round := 0; round < 10; round++ {
+			g := randomCompleteGraph(t, n, rng)
+			tour, weight, err := g.TSPExact()
+			if err != nil {
+				t.Fatalf("TSPExact() error = %v", err)
+			}
+			checkTour(t, g, tour, "0")
+			if got := tourWeight(t, g, tour); got != weight {
+				t.Errorf("вес цикла %v = %d, TSPExact() = %d", tour, got, weight)
+			}
+			if want := bruteForceTSP(t, g); weight != want {
+				t.Errorf("TSPExact() на %d вершинах = %d, want %d", n, weight, want)
+			}
+		}
+	}
+
+	single := NewEmptyGraph()
+	single.is_oriented = false
+	single.AddNode("a")
+	names := make([]string, maxNodesForTSPExact+1)
+	for i := range names {
+		names[i] = strconv.Itoa(i)
+	}
+	tests := []struct {
+		name       string
+		g          *Graph
+		want       []string
+		wantWeight int
+		wantErr    bool
+	}{
+		{"одна вершина", single, []string{"a"}, 0, false},
+		{"невзвешенный K_4", NewCompleteGraph([]string{"d", "c", "b", "a"}), nil, 4, false},
+		{"пустой граф", NewEmptyGraph(), nil, 0, true},
+		{"не полный граф", NewCycleGraph(4), nil, 0, true},
+		{"орграф", mustGraph(t, true, true, [][3]string{{"a", "b", "1"}, {"b", "a", "1"}}), nil, 0, true},
+		{"больше 15 вершин", NewCompleteGraph(names), nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, weight, err := tt.g.TSPExact()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TSPExact() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			// Оптимальных циклов может быть несколько, поэтому порядок проверяется, только если он задан
+			if weight != tt.wantWeight || (tt.want != nil && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("TSPExact() = %v, %d, want %v, %d", got, weight, tt.want, tt.wantWeight)
+			}
+			checkTour(t, tt.g, got, tt.g.nodeValues()[0])
+		})
+	}
+}
+
+func TestHamiltonianPath(t *testing.T) {
+	single := NewEmptyGraph()
+	single.AddNode("a")
+	disconnected := NewPathGraph(2)
+	disconnected.AddNode("z")
+	tests := []struct {
+		name    string
+		g       *Graph
+		wantOK  bool
+		wantErr bool
+	}{
+		{"путь", NewPathGraph(6), true, false},
+		{"цикл", NewCycleGraph(7), true, false},
+		{"решетка 3 x 3", NewGridGraph(3, 3), true, false},
+		{"звезда", starGraph(t, "a", "b", "d"), false, false},
+		{"звезда с двумя листьями", starGraph(t, "a", "b"), true, false},
+		{"орграф с путем", mustGraph(t, true, false, [][3]string{{"c", "a"}, {"a", "b"}, {"b", "d"}, {"d", "a"}}), true, false},
+		{"орграф без пути", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"a", "c"}}), false, false},
+		{"несвязный граф", disconnected, false, false},
+		{"одна вершина", single, true, false},
+		{"пустой граф", NewEmptyGraph(), false, false},
+		{"больше 20 вершин", NewPathGraph(maxNodesForHamiltonian + 1), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, ok, err := tt.g.HamiltonianPath()
+			if (err != nil) != tt.wantErr || ok != tt.wantOK {
+				t.Fatalf("HamiltonianPath() = %v, %v, %v, want ok %v, wantErr %v", path, ok, err, tt.wantOK, tt.wantErr)
+			}
+			if !ok {
+				if path != nil {
+					t.Errorf("HamiltonianPath() = %v без пути", path)
+				}
+				return
+			}
+			// Путь проходит каждую вершину один раз по существующим связям
+			seen := map[string]bool{}
+			for i, v := range path {
+				if seen[v] {
+					t.Errorf("путь %v проходит %s повторно", path, v)
+				}
+				seen[v] = true
+				if i > 0 {
+					if _, ok := tt.g.edgeWeight(path[i-1], v); !ok {
+						t.Errorf("в пути %v нет связи %s - %s", path, path[i-1], v)
+					}
+				}
+			}
+			if len(seen) != len(tt.g.nodeValues()) {
+				t.Errorf("путь %v проходит не все вершины", path)
+			}
+		})
+	}
+}
+
+func TestTSPNearestNeighbor(t *testing.T) {
+	// Из a ребра в b и c равны по весу, выбирается меньшая по значению b
+	square := mustGraph(t, false, true, [][3]string{{"a", "b", "1"}, {"a", "c", "1"}, {"a", "d", "4"}, {"b", "c", "2"}, {"b", "d", "3"}, {"c", "d", "5"}})
+	single := NewEmptyGraph()
+	single.is_oriented = false
+	single.AddNode("a")
+	tests := []struct {
+		name       string
+		g          *Graph
+		start      string
+		want       []string
+		wantWeight int
+		wantErr    bool
+	}{
+		{"равные ребра - меньшая вершина", square, "a", []string{"a", "b", "c", "d"}, 12, false},
+		{"другая начальная вершина", square, "d", []string{"d", "b", "a", "c"}, 10, false},
+		{"одна вершина", single, "a", []string{"a"}, 0, false},
+		{"нет вершины", square, "x", nil, 0, true},
+		{"не полный граф", NewCycleGraph(4), "0", nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, weight, err := tt.g.TSPNearestNeighbor(tt.start)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TSPNearestNeighbor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) || weight != tt.wantWeight {
+				t.Errorf("TSPNearestNeighbor() = %v, %d, want %v, %d", got, weight, tt.want, tt.wantWeight)
+			}
+		})
+	}
+
+	// Жадный цикл не бывает легче оптимального
+	rng := rand.New(rand.NewSource(8))
+	for n := 2; n <= 7; n++ {
+		for round := 0; round < 10; round++ {
+			g := randomCompleteGraph(t, n, rng)
+			_, best, err := g.TSPExact()
+			if err != nil {
+				t.Fatalf("TSPExact() error = %v", err)
+			}
+			for _, start := range g.nodeValues() {
+				tour, weight, err := g.TSPNearestNeighbor(start)
+				if err != nil {
+					t.Fatalf("TSPNearestNeighbor() error = %v", err)
+				}
+				checkTour(t, g, tour, start)
+				if got := tourWeight(t, g, tour); got != weight {
+					t.Errorf("вес цикла %v = %d, TSPNearestNeighbor() = %d", tour, got, weight)
+				}
+				if weight < best {
+					t.Errorf("TSPNearestNeighbor(%s) = %d легче TSPExact() = %d", start, weight, best)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package graph
+
+import "errors"
+
+/*
+
+Топологические индексы графа:
+- WienerPolarity - индекс полярности Винера
+- ClosenessVitality - вклад каждой вершины в индекс Винера
+
+*/
+
+// hopDistances - находит кратчайшие расстояния (в количестве дуг / ребер) между всеми парами вершин
+// обходом в ширину из каждой вершины. Недостижимые пары в словарь не попадают
+func (g *Graph) hopDistances() map[*Node]map[*Node]int {
+	result := make(map[*Node]map[*Node]int, len(g.edges))
+	for source := range g.edges {
+		result[source] = g.hopsFrom(source)
+	}
+	return result
+}
+
+// wienerIndex - возвращает индекс Винера - сумму кратчайших расстояний (в ребрах) между всеми парами вершин.
+// Недостижимые пары не учитываются. Для неориентированного графа пары неупорядоченные
+func (g *Graph) wienerIndex() int {
+	sum := 0
+	for _, distances := range g.hopDistances() {
+		for _, d := range distances {
+			sum += d
+		}
+	}
+	if !g.is_oriented {
+		sum /= 2 // каждая пара посчитана дважды
+	}
+	return sum
+}
+
+// WienerPolarity - возвращает индекс полярности Винера - количество пар вершин,
+// кратчайшее расстояние между которыми равно ровно 3 ребрам.
+// Для неориентированного графа пары неупорядоченные, для орграфа учитывается направление дуг
+func (g *Graph) WienerPolarity() int {
+	count := 0
+	for _, distances := range g.hopDistances() {
+		for _, d := range distances {
+			if d == 3 {
+				count++
+			}
+		}
+	}
+	if !g.is_oriented {
+		count /= 2 // каждая пара посчитана дважды
+	}
+	return count
+}
+
+// ClosenessVitality - возвращает для каждой вершины изменение индекса Винера при ее удалении:
+// индекс исходного графа минус индекс графа без этой вершины.
+// Недостижимые пары в индексе не учитываются, поэтому вершина, разрывающая граф, получает большое значение.
+// Индекс пересчитывается на копии графа для каждой вершины, сложность O(V^2 * (V + E))
+func (g *Graph) ClosenessVitality() (map[string]int, error) {
+	if len(g.edges) == 0 {
+		return nil, errors.New("Граф не содержит вершин")
+	}
+	total := g.wienerIndex()
+	result := make(map[string]int, len(g.edges))
+	for node := range g.edges {
+		workingGraph := NewCopiedGraph(g)
+		workingGraph.RemoveNode(node.toString())
+		result[node.toString()] = total - workingGraph.wienerIndex()
+	}
+	return result, nil
+}
@@ -0,0 +1,53 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWienerPolarity(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *Graph
+		want int
+	}{
+		{"путь из 3 вершин", NewPathGraph(3), 0},
+		{"путь из 4 вершин", NewPathGraph(4), 1},
+		{"путь из 6 вершин", NewPathGraph(6), 3},
+		{"цикл из 6 вершин", NewCycleGraph(6), 3},
+		{"цикл из 5 вершин", NewCycleGraph(5), 0},
+		{"ориентированный путь", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "d"}}), 1},
+		{"пустой граф", NewEmptyGraph(), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.WienerPolarity(); got != tt.want {
+				t.Errorf("WienerPolarity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClosenessVitality(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *Graph
+		want    map[string]int
+		wantErr bool
+	}{
+		{"путь из 5 вершин", NewPathGraph(5), map[string]int{"0": 10, "1": 16, "2": 18, "3": 16, "4": 10}, false},
+		{"цикл из 4 вершин", NewCycleGraph(4), map[string]int{"0": 4, "1": 4, "2": 4, "3": 4}, false},
+		{"пустой граф", NewEmptyGraph(), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.ClosenessVitality()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ClosenessVitality() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClosenessVitality() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
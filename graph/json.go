@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+/*
+
+Сериализация графа в JSON:
+- MarshalJSON - представляет граф в виде JSON
+- UnmarshalJSON - восстанавливает граф из JSON
+
+*/
+
+// graphData - представление графа для сериализации (JSON, gob):
+// флаги графа (включая запрет петель), список всех узлов (в том числе изолированных), список дуг / ребер (включая параллельные)
+// и атрибуты узлов
+type graphData struct {
+	Oriented   bool     `json:"oriented"`
+	Suspended  bool     `json:"suspended"`
+	Multigraph bool     `json:"multigraph,omitempty"`
+	NoLoops    bool     `json:"no_self_loops,omitempty"`
+	Nodes      []string `json:"nodes"`
+	Edges      []Edge   `json:"edges"`
+
+	Attributes map[string]map[string]string `json:"attributes,omitempty"`
+}
+
+// MarshalJSON - представляет граф в виде JSON,
+// ребро неориентированного графа записывается один раз
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.snapshot())
+}
+
+// snapshot - возвращает сериализуемое представление графа
+func (g *Graph) snapshot() graphData {
+	var attributes map[string]map[string]string
+	for node, values := range g.attributes {
+		if len(values) == 0 {
+			continue
+		}
+		if attributes == nil {
+			attributes = make(map[string]map[string]string)
+		}
+		attributes[node.toString()] = make(map[string]string, len(values))
+		for key, value := range values {
+			attributes[node.toString()][key] = value
+		}
+	}
+	return graphData{
+		Oriented:   g.is_oriented,
+		Suspended:  g.is_suspended,
+		Multigraph: g.is_multigraph,
+		NoLoops:    g.no_self_loops,
+		Nodes:      g.nodeValues(),
+		Edges:      g.edgeList(),
+		Attributes: attributes,
+	}
+}
+
+// UnmarshalJSON - восстанавливает граф из JSON, полностью заменяя текущие узлы и связи
+func (g *Graph) UnmarshalJSON(data []byte) error {
+	var raw graphData
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	return g.restore(raw)
+}
+
+// restore - заменяет узлы, связи и флаги графа (в том числе политику петель) сериализованными данными.
+// Если связь не может быть добавлена (например, петля при запрете петель), возвращает ошибку
+func (g *Graph) restore(raw graphData) error {
+	g.is_oriented = raw.Oriented
+	g.is_suspended = raw.Suspended
+	g.edges = make(map[*Node]map[*Node]int)
+	g.in_degree = make(map[*Node]int)
+	g.index = make(map[string]*Node)
+	g.is_multigraph = raw.Multigraph
+	g.no_self_loops = raw.NoLoops
+	g.parallel = nil
+	if raw.Multigraph {
+		g.parallel = make(map[*Node]map[*Node][]int)
+	}
+	for _, value := range raw.Nodes {
+		g.addNode(value)
+	}
+	for _, e := range raw.Edges {
+		if err := g.AddEdge(e.From, e.To, e.Weight); err != nil {
+			return fmt.Errorf("Связь %s - %s: %w", e.From, e.To, err)
+		}
+	}
+	g.attributes = nil
+	for node, values := range raw.Attributes {
+		for key, value := range values {
+			g.SetNodeAttr(node, key, value)
+		}
+	}
+	return nil
+}
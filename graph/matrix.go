@@ -0,0 +1,43 @@
+package graph
+
+import "math"
+
+// NoEdge - значение ячейки матрицы смежности взвешенного графа, означающее отсутствие связи
+// (вес 0 во взвешенном графе допустим, поэтому используется заведомо недостижимое значение)
+const NoEdge = math.MinInt
+
+// AdjacencyMatrix - возвращает отсортированные названия узлов и квадратную матрицу смежности в том же порядке.
+// Для невзвешенного графа связь обозначается 1, отсутствие связи - 0.
+// Для взвешенного графа в ячейке записан вес связи, отсутствие связи - NoEdge.
+// Матрица неориентированного графа симметрична
+func (g *Graph) AdjacencyMatrix() (labels []string, matrix [][]int) {
+	labels = g.nodeValues()
+	index := make(map[string]int, len(labels))
+	for i, label := range labels {
+		index[label] = i
+	}
+	absent := 0
+	if g.is_suspended {
+		absent = NoEdge
+	}
+	matrix = make([][]int, len(labels))
+	for i := range matrix {
+		matrix[i] = make([]int, len(labels))
+		for j := range matrix[i] {
+			matrix[i][j] = absent
+		}
+	}
+	for node, v := range g.edges {
+		for next, w := range v {
+			if !g.is_suspended {
+				w = 1
+			}
+			i, j := index[node.toString()], index[next.toString()]
+			matrix[i][j] = w
+			if !g.is_oriented {
+				matrix[j][i] = w
+			}
+		}
+	}
+	return labels, matrix
+}
@@ -0,0 +1,359 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+/*
+
+Операции над графами:
+- Transpose - обращение всех дуг орграфа
+- Complement - дополнение невзвешенного графа
+- InducedSubgraph - подграф, порожденный множеством вершин
+- Union, Intersection, Difference - объединение, пересечение и разность графов
+- ContractEdge - стягивание ребра
+- MergeNodes - слияние двух вершин
+- LineGraph - реберный граф
+- Equal - сравнение графов
+
+Операции над двумя графами сопоставляют вершины по значениям. Результат получает ориентированность,
+взвешенность и признак мультиграфа графа-получателя; если флаги графов различаются, связи второго графа
+берутся в том виде, в котором они хранятся (ребро неориентированного графа - как две дуги).
+
+*/
+
+// Transpose - возвращает новый граф, в котором все дуги обращены (веса и параллельные дуги мультиграфа сохраняются).
+// Для неориентированного графа возвращает его копию
+func (g *Graph) Transpose() *Graph {
+	result := newGraphLike(g)
+	for node, v := range g.edges {
+		result.addNode(node.toString())
+		for next := range v {
+			copyLinks(result, g, node, next, next.toString(), node.toString())
+		}
+	}
+	return result
+}
+
+// Complement - возвращает дополнение невзвешенного графа: те же вершины и ровно те связи, которых нет
+// в исходном графе (без петель). Для орграфа дополняются дуги каждого направления отдельно.
+// Для взвешенного графа возвращает ошибку, так как веса дополнения не определены
+func (g *Graph) Complement() (*Graph, error) {
+	if g.is_suspended {
+		return nil, errors.New("Дополнение определено только для невзвешенного графа")
+	}
+	result := NewEmptyGraph()
+	result.is_oriented = g.is_oriented
+	result.is_suspended = false
+	for node := range g.edges {
+		result.addNode(node.toString())
+	}
+	for node := range g.edges {
+		for other := range g.edges {
+			if _, ok := g.edges[node][other]; !ok && node != other {
+				result.AddEdge(node.toString(), other.toString(), -1)
+			}
+		}
+	}
+	return result, nil
+}
+
+// InducedSubgraph - возвращает подграф, содержащий только заданные вершины и все связи между ними,
+// с той же ориентированностью и взвешенностью. Если какой-то вершины нет в графе, возвращает ошибку
+func (g *Graph) InducedSubgraph(nodes []string) (*Graph, error) {
+	selected := make(map[*Node]bool, len(nodes))
+	for _, value := range nodes {
+		if err := validateNode(g, value); err != nil {
+			return nil, err
+		}
+		selected[g.getRefOfNode(value)] = true
+	}
+	result := newGraphLike(g)
+	for node := range selected {
+		result.addNode(node.toString())
+		for next := range g.edges[node] {
+			if selected[next] {
+				copyLinks(result, g, node, next, node.toString(), next.toString())
+			}
+		}
+	}
+	return result, nil
+}
+
+// edgeWeight - возвращает вес связи между вершинами с заданными значениями и признак ее наличия
+func (g *Graph) edgeWeight(value1, value2 string) (int, bool) {
+	node1 := g.getRefOfNode(value1)
+	node2 := g.getRefOfNode(value2)
+	if node1 == nil || node2 == nil {
+		return 0, false
+	}
+	w, ok := g.edges[node1][node2]
+	return w, ok
+}
+
+// newGraphLike - возвращает пустой граф с флагами графа g (в том числе признаком мультиграфа)
+func newGraphLike(g *Graph) *Graph {
+	result := NewEmptyGraph()
+	result.is_oriented = g.is_oriented
+	result.is_suspended = g.is_suspended
+	if g.is_multigraph {
+		result.is_multigraph = true
+		result.parallel = make(map[*Node]map[*Node][]int)
+	}
+	return result
+}
+
+// copyLinks - добавляет в result связи from - to с весами связей node -> next графа g:
+// в мультиграф - все параллельные связи, в обычный граф - одну с минимальным весом.
+// Связи невзвешенного g попадают во взвешенный result с единичным весом. Ребро, которое
+// хранится в неориентированных g и result в обоих направлениях, переносится один раз
+func copyLinks(result, g *Graph, node, next *Node, from, to string) {
+	if !g.is_oriented && !result.is_oriented && node.toString() > next.toString() {
+		return
+	}
+	weights := []int{g.edges[node][next]}
+	if result.is_multigraph {
+		weights = g.weightsBetween(node, next)
+	}
+	for _, w := range weights {
+		result.AddEdge(from, to, g.cost(w))
+	}
+}
+
+// Union - возвращает объединение графов: все вершины и связи обоих графов.
+// Если связь есть в обоих графах, сохраняются веса (в мультиграфе - параллельные связи) графа-получателя.
+// Связи невзвешенного второго графа попадают во взвешенный результат с единичным весом
+func (g *Graph) Union(other *Graph) *Graph {
+	result := newGraphLike(g)
+	for node, v := range g.edges {
+		result.addNode(node.toString())
+		for next := range v {
+			copyLinks(result, g, node, next, node.toString(), next.toString())
+		}
+	}
+	for node, v := range other.edges {
+		result.addNode(node.toString())
+		for next := range v {
+			if _, ok := g.edgeWeight(node.toString(), next.toString()); !ok {
+				copyLinks(result, other, node, next, node.toString(), next.toString())
+			}
+		}
+	}
+	return result
+}
+
+// Intersection - возвращает пересечение графов: общие вершины и связи, которые есть в обоих графах,
+// с весами графа-получателя
+func (g *Graph) Intersection(other *Graph) *Graph {
+	result := newGraphLike(g)
+	for node, v := range g.edges {
+		if other.getRefOfNode(node.toString()) == nil {
+			continue
+		}
+		result.addNode(node.toString())
+		for next := range v {
+			if _, ok := other.edgeWeight(node.toString(), next.toString()); ok {
+				copyLinks(result, g, node, next, node.toString(), next.toString())
+			}
+		}
+	}
+	return result
+}
+
+// Difference - возвращает разность графов: все вершины графа-получателя и те его связи,
+// которых нет во втором графе (веса при сравнении не учитываются)
+func (g *Graph) Difference(other *Graph) *Graph {
+	result := newGraphLike(g)
+	for node, v := range g.edges {
+		result.addNode(node.toString())
+		for next := range v {
+			if _, ok := other.edgeWeight(node.toString(), next.toString()); !ok {
+				copyLinks(result, g, node, next, node.toString(), next.toString())
+			}
+		}
+	}
+	return result
+}
+
+// ContractEdge - стягивает ребро (дугу в любом направлении) между u и v: вершина v сливается с u,
+// все связи v переносятся на u, а сама v удаляется. При совпадении связей сохраняется минимальный вес,
+// петли, возникающие при стягивании, отбрасываются. Если связи между u и v нет, возвращает ошибку
+func (g *Graph) ContractEdge(u, v string) error {
+	if err := validateNode(g, u); err != nil {
+		return err
+	}
+	if err := validateNode(g, v); err != nil {
+		return err
+	}
+	if u == v {
+		return errors.New("Нельзя стянуть петлю")
+	}
+	_, forward := g.edgeWeight(u, v)
+	_, backward := g.edgeWeight(v, u)
+	if !forward && !backward {
+		return errors.New("Между вершинами " + u + " и " + v + " нет связи")
+	}
+	g.mergeNodes(g.getRefOfNode(u), g.getRefOfNode(v), false)
+	return nil
+}
+
+// MergeNodes - сливает вершину merge с вершиной keep (например, одну вершину, записанную в файле двумя способами):
+// связи merge переносятся на keep, при совпадении сохраняется минимальный вес, после чего merge удаляется.
+// В отличие от ContractEdge связь между вершинами не требуется. Связи между keep и merge становятся петлей keep,
+// если петли в графе разрешены, и отбрасываются, если запрещены (см. AllowSelfLoops). Атрибуты merge не переносятся.
+// Если какой-то вершины нет или keep совпадает с merge, возвращает ошибку
+func (g *Graph) MergeNodes(keep, merge string) error {
+	if err := validateNode(g, keep); err != nil {
+		return err
+	}
+	if err := validateNode(g, merge); err != nil {
+		return err
+	}
+	if keep == merge {
+		return errors.New("Нельзя слить вершину с самой собой")
+	}
+	g.mergeNodes(g.getRefOfNode(keep), g.getRefOfNode(merge), !g.no_self_loops)
+	return nil
+}
+
+// mergeNodes - сливает вершину merge с вершиной keep: связи merge переносятся на keep
+// (при совпадении, в том числе для параллельных связей мультиграфа, сохраняется минимальный вес),
+// после чего merge удаляется.
+// keepLoops определяет, сохраняются ли петли, возникшие из связей между keep и merge
+func (g *Graph) mergeNodes(keep, merge *Node, keepLoops bool) {
+	redirect := func(n *Node) *Node {
+		if n == merge {
+			return keep
+		}
+		return n
+	}
+	setMin := func(from, to *Node, w int) {
+		if from == to && !keepLoops {
+			return // петля, возникшая при слиянии (уже имевшаяся петля keep не изменяется)
+		}
+		old, ok := g.edges[from][to]
+		if !ok {
+			g.in_degree[to]++
+		}
+		if !ok || w < old {
+			g.edges[from][to] = w
+		}
+		if g.is_multigraph {
+			// Параллельные связи объединяются в одну с минимальным весом
+			if g.parallel[from] == nil {
+				g.parallel[from] = map[*Node][]int{}
+			}
+			g.parallel[from][to] = []int{g.edges[from][to]}
+		}
+	}
+	// Исходящие связи merge
+	for next, w := range g.edges[merge] {
+		setMin(keep, redirect(next), w)
+	}
+	// Входящие связи merge
+	for node, v := range g.edges {
+		if node == merge {
+			continue
+		}
+		if w, ok := v[merge]; ok {
+			setMin(node, keep, w)
+		}
+	}
+	for node := range g.edges {
+		g.deleteEdge(node, merge)
+	}
+	g.dropNode(merge)
+}
+
+// LineGraph - возвращает реберный граф: его вершины - связи исходного графа с названиями "u-v",
+// а связи соединяют смежные связи исходного графа. Символы "-" и "\" в значениях вершин экранируются
+// обратной косой чертой (ребро "a-b" - "c" дает вершину "a\-b-c"), поэтому названия разных связей не совпадают.
+// Для неориентированного графа ребра "u-v" (u <= v) соединяются, если имеют общий конец. Для орграфа строится
+// реберный орграф: дуга из "u-v" в "v-w" проводится, если конец первой дуги совпадает с началом второй.
+// Параллельные связи мультиграфа дают одну вершину, петля становится вершиной, но не соединяется сама с собой.
+// Результат невзвешенный и имеет ориентированность исходного графа
+func (g *Graph) LineGraph() *Graph {
+	result := NewEmptyGraph()
+	result.is_oriented = g.is_oriented
+	result.is_suspended = false
+	escape := strings.NewReplacer("\\", "\\\\", "-", "\\-")
+	name := func(from, to *Node) string {
+		return escape.Replace(from.toString()) + "-" + escape.Replace(to.toString())
+	}
+	incident := make(map[*Node][]string, len(g.edges)) // названия ребер, инцидентных вершине
+	for node, v := range g.edges {
+		for next := range v {
+			if !g.is_oriented && node.toString() > next.toString() {
+				continue
+			}
+			result.addNode(name(node, next))
+			if g.is_oriented {
+				continue
+			}
+			incident[node] = append(incident[node], name(node, next))
+			if next != node {
+				incident[next] = append(incident[next], name(node, next))
+			}
+		}
+	}
+	if g.is_oriented {
+		for node, v := range g.edges {
+			for next := range v {
+				for after := range g.edges[next] {
+					if name(node, next) != name(next, after) {
+						result.AddEdge(name(node, next), name(next, after), -1)
+					}
+				}
+			}
+		}
+		return result
+	}
+	for _, edges := range incident {
+		for i := range edges {
+			for j := i + 1; j < len(edges); j++ {
+				result.AddEdge(edges[i], edges[j], -1)
+			}
+		}
+	}
+	return result
+}
+
+// Equal - проверяет, совпадают ли графы: ориентированность, взвешенность, множества вершин
+// и связи вместе с весами (для мультиграфа - с учетом всех параллельных связей).
+// Вершины сопоставляются по значениям, а не по указателям
+func (g *Graph) Equal(other *Graph) bool {
+	if g.is_oriented != other.is_oriented || g.is_suspended != other.is_suspended ||
+		g.is_multigraph != other.is_multigraph || len(g.edges) != len(other.edges) {
+		return false
+	}
+	for node, v := range g.edges {
+		otherNode := other.getRefOfNode(node.toString())
+		if otherNode == nil || len(v) != len(other.edges[otherNode]) {
+			return false
+		}
+		for next := range v {
+			otherNext := other.getRefOfNode(next.toString())
+			if otherNext == nil {
+				return false
+			}
+			if _, ok := other.edges[otherNode][otherNext]; !ok {
+				return false
+			}
+			weights := append([]int{}, g.weightsBetween(node, next)...)
+			otherWeights := append([]int{}, other.weightsBetween(otherNode, otherNext)...)
+			if len(weights) != len(otherWeights) {
+				return false
+			}
+			sort.Ints(weights)
+			sort.Ints(otherWeights)
+			for i := range weights {
+				if weights[i] != otherWeights[i] {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,247 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranspose(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		edges    [][3]string
+		want     map[[2]string]int
+	}{
+		{"орграф", true, [][3]string{{"a", "b", "3"}, {"b", "c", "5"}, {"c", "c", "1"}},
+			map[[2]string]int{{"b", "a"}: 3, {"c", "b"}: 5, {"c", "c"}: 1}},
+		{"встречные дуги", true, [][3]string{{"a", "b", "3"}, {"b", "a", "4"}},
+			map[[2]string]int{{"b", "a"}: 3, {"a", "b"}: 4}},
+		{"неориентированный граф", false, [][3]string{{"a", "b", "3"}},
+			map[[2]string]int{{"a", "b"}: 3, {"b", "a"}: 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, true, tt.edges)
+			g.AddNode("z")
+			transposed := g.Transpose()
+			for e, want := range tt.want {
+				if got, ok := transposed.edgeWeight(e[0], e[1]); !ok || got != want {
+					t.Errorf("вес %s - %s = %d, %v, want %d", e[0], e[1], got, ok, want)
+				}
+			}
+			if !transposed.HasNode("z") {
+				t.Error("изолированная вершина потеряна")
+			}
+			if !g.Equal(transposed.Transpose()) {
+				t.Errorf("двойное обращение = %v, want %v", transposed.Transpose().Edges(), g.Edges())
+			}
+		})
+	}
+}
+
+func TestTransposeMultigraph(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		want     map[[2]string][]int
+	}{
+		{"ориентированный мультиграф", true,
+			map[[2]string][]int{{"b", "a"}: {4, 2}, {"a", "b"}: {}, {"c", "b"}: {1}, {"c", "c"}: {5, 6}}},
+		{"неориентированный мультиграф", false,
+			map[[2]string][]int{{"a", "b"}: {4, 2}, {"b", "a"}: {4, 2}, {"b", "c"}: {1}, {"c", "c"}: {5, 6}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewEmptyMultigraph()
+			g.is_oriented = tt.oriented
+			for _, e := range []Edge{{"a", "b", 4}, {"a", "b", 2}, {"b", "c", 1}, {"c", "c", 5}, {"c", "c", 6}} {
+				g.AddEdge(e.From, e.To, e.Weight)
+			}
+			transposed := g.Transpose()
+			if !transposed.is_multigraph {
+				t.Fatal("Transpose() мультиграфа - не мультиграф")
+			}
+			for e, want := range tt.want {
+				if got := transposed.ParallelEdges(e[0], e[1]); !reflect.DeepEqual(got, want) {
+					t.Errorf("ParallelEdges(%s, %s) = %v, want %v", e[0], e[1], got, want)
+				}
+			}
+			if errs := transposed.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+			if !g.Equal(transposed.Transpose()) {
+				t.Errorf("двойное обращение = %v, want %v", transposed.Transpose().Edges(), g.Edges())
+			}
+			// Остальные операции тоже сохраняют параллельные связи
+			if sub, err := g.InducedSubgraph([]string{"a", "b"}); err != nil || !reflect.DeepEqual(sub.ParallelEdges("a", "b"), []int{4, 2}) {
+				t.Errorf("InducedSubgraph() = %v, %v", sub, err)
+			}
+			if union := g.Union(NewEmptyGraph()); !g.Equal(union) {
+				t.Errorf("Union() с пустым графом = %v, want %v", union.Edges(), g.Edges())
+			}
+		})
+	}
+}
+
+func TestComplement(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		weighted bool
+		edges    [][3]string
+		wantErr  bool
+	}{
+		{"путь", false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "d"}}, false},
+		{"петля не попадает в дополнение", false, false, [][3]string{{"a", "a"}, {"a", "b"}, {"c", "c"}}, false},
+		{"орграф", true, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"a", "c"}}, false},
+		{"взвешенный граф", false, true, [][3]string{{"a", "b", "1"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, tt.weighted, tt.edges)
+			complement, err := g.Complement()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Complement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(complement.SelfLoops()) != 0 {
+				t.Errorf("дополнение содержит петли %v", complement.SelfLoops())
+			}
+			// Связи графа без петель и связи дополнения не пересекаются и вместе дают полный граф
+			n := len(g.edges)
+			want := n * (n - 1)
+			if !tt.oriented {
+				want /= 2
+			}
+			if got := len(g.Edges()) - len(g.SelfLoops()) + len(complement.Edges()); got != want {
+				t.Errorf("связей графа и дополнения %d, want %d", got, want)
+			}
+			if len(g.Intersection(complement).Edges()) != 0 {
+				t.Errorf("граф и дополнение имеют общие связи")
+			}
+			if !tt.oriented {
+				full := g.Union(complement)
+				for _, value := range full.SelfLoops() {
+					full.RemoveEdge(value, value)
+				}
+				if !full.Equal(NewCompleteGraph(g.nodeValues())) {
+					t.Errorf("объединение с дополнением %v не является полным графом", full.Edges())
+				}
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	base := [][3]string{{"a", "b", "1"}, {"b", "c", "2"}}
+	multigraph := func(weights ...int) *Graph {
+		g := NewEmptyMultigraph()
+		for _, w := range weights {
+			g.AddEdge("a", "b", w)
+		}
+		return g
+	}
+	withNode := mustGraph(t, true, true, base)
+	withNode.AddNode("z")
+	tests := []struct {
+		name     string
+		g, other *Graph
+		want     bool
+	}{
+		{"тот же набор связей", mustGraph(t, true, true, base), mustGraph(t, true, true, [][3]string{{"b", "c", "2"}, {"a", "b", "1"}}), true},
+		{"копия", withNode, NewCopiedGraph(withNode), true},
+		{"другой вес", mustGraph(t, true, true, base), mustGraph(t, true, true, [][3]string{{"a", "b", "1"}, {"b", "c", "3"}}), false},
+		{"другое направление", mustGraph(t, true, true, base), mustGraph(t, true, true, [][3]string{{"b", "a", "1"}, {"b", "c", "2"}}), false},
+		{"другая ориентированность", mustGraph(t, true, true, base), mustGraph(t, false, true, base), false},
+		{"другая взвешенность", mustGraph(t, true, true, base), mustGraph(t, true, false, base), false},
+		{"изолированная вершина", mustGraph(t, true, true, base), withNode, false},
+		{"параллельные связи в другом порядке", multigraph(1, 5, 1), multigraph(5, 1, 1), true},
+		{"другое число параллельных связей", multigraph(1, 5), multigraph(1, 5, 5), false},
+		{"пустые графы", NewEmptyGraph(), NewEmptyGraph(), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.Equal(tt.other); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := tt.other.Equal(tt.g); got != tt.want {
+				t.Errorf("Equal() в обратную сторону = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name          string
+		weighted      bool
+		otherWeighted bool
+		edges, other  [][3]string
+		want          map[[2]string]int
+	}{
+		{"вес получателя сохраняется", true, true,
+			[][3]string{{"a", "b", "5"}}, [][3]string{{"a", "b", "7"}, {"b", "c", "2"}},
+			map[[2]string]int{{"a", "b"}: 5, {"b", "c"}: 2}},
+		{"невзвешенный второй граф дает единичные веса", true, false,
+			[][3]string{{"a", "b", "5"}}, [][3]string{{"b", "c"}, {"c", "d"}},
+			map[[2]string]int{{"a", "b"}: 5, {"b", "c"}: 1, {"c", "d"}: 1}},
+		{"невзвешенный получатель", false, true,
+			[][3]string{{"a", "b"}}, [][3]string{{"b", "c", "4"}},
+			map[[2]string]int{{"a", "b"}: -1, {"b", "c"}: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, false, tt.weighted, tt.edges)
+			other := mustGraph(t, false, tt.otherWeighted, tt.other)
+			result := g.Union(other)
+			if got := len(result.Edges()); got != len(tt.want) {
+				t.Errorf("len(Edges()) = %d, want %d", got, len(tt.want))
+			}
+			for e, want := range tt.want {
+				if got, ok := result.edgeWeight(e[0], e[1]); !ok || got != want {
+					t.Errorf("вес %s - %s = %d, %v, want %d", e[0], e[1], got, ok, want)
+				}
+			}
+			if errs := result.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		})
+	}
+}
+
+func TestLineGraph(t *testing.T) {
+	tests := []struct {
+		name      string
+		oriented  bool
+		edges     [][3]string
+		wantNodes []string
+		wantEdges []Edge
+	}{
+		{"орграф с петлей", true, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"b", "b"}},
+			[]string{"a-b", "b-b", "b-c", "c-a"},
+			[]Edge{{"a-b", "b-b", -1}, {"a-b", "b-c", -1}, {"b-b", "b-c", -1}, {"b-c", "c-a", -1}, {"c-a", "a-b", -1}}},
+		{"неориентированный граф с петлей", false, [][3]string{{"a", "b"}, {"c", "b"}, {"b", "b"}},
+			[]string{"a-b", "b-b", "b-c"},
+			[]Edge{{"a-b", "b-b", -1}, {"a-b", "b-c", -1}, {"b-b", "b-c", -1}}},
+		{"дефис в значениях не склеивает ребра", false, [][3]string{{"a-b", "c"}, {"a", "b-c"}},
+			[]string{`a-b\-c`, `a\-b-c`}, []Edge{}},
+		{"экранирование в орграфе", true, [][3]string{{"x-1", "y"}, {"y", `z\`}},
+			[]string{`x\-1-y`, `y-z\\`}, []Edge{{`x\-1-y`, `y-z\\`, -1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := mustGraph(t, tt.oriented, false, tt.edges).LineGraph()
+			if line.IsOriented() != tt.oriented || line.IsWeighted() {
+				t.Errorf("IsOriented(), IsWeighted() = %v, %v, want %v, false", line.IsOriented(), line.IsWeighted(), tt.oriented)
+			}
+			if got := line.nodeValues(); !reflect.DeepEqual(got, tt.wantNodes) {
+				t.Errorf("LineGraph() вершины = %v, want %v", got, tt.wantNodes)
+			}
+			if got := line.Edges(); !reflect.DeepEqual(got, tt.wantEdges) {
+				t.Errorf("LineGraph() связи = %v, want %v", got, tt.wantEdges)
+			}
+		})
+	}
+}
@@ -0,0 +1,486 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+/*
+
+Поиск путей со специальными условиями:
+- LongestIncreasingWeightPath - самый длинный путь с возрастающими весами
+- BottleneckPath - путь с минимальным максимальным весом связи
+- WidestPath - путь с максимальным минимальным весом связи
+- HasNegativeEdge - наличие связей отрицательного веса
+- AllPairsShortestPaths - кратчайшие расстояния и пути между всеми парами вершин
+- ShortestPathAvoiding - кратчайший путь, не проходящий через заданные вершины
+- ShortestPathAvoidingEdges - кратчайший путь, не проходящий по заданным связям
+- ShortestPathMaxHops - кратчайший путь из не более чем k связей
+- SecondShortestPath - второй по длине путь
+
+*/
+
+// ErrNoPath - ошибка поиска пути: подходящего пути не существует
+var ErrNoPath = errors.New("Пути не существует")
+
+// arc - дуга графа с весом, используется алгоритмами, перебирающими дуги
+type arc struct {
+	from, to *Node
+	weight   int
+}
+
+// arcs - возвращает все дуги графа, отсортированные по весу, а при равенстве - по значениям концов.
+// Ребро неориентированного графа дает две дуги
+func (g *Graph) arcs() []arc {
+	result := []arc{}
+	for from, v := range g.edges {
+		for to, w := range v {
+			result = append(result, arc{from, to, w})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].weight != result[j].weight {
+			return result[i].weight < result[j].weight
+		}
+		if result[i].from.toString() != result[j].from.toString() {
+			return result[i].from.toString() < result[j].from.toString()
+		}
+		return result[i].to.toString() < result[j].to.toString()
+	})
+	return result
+}
+
+// LongestIncreasingWeightPath - находит самый длинный путь, в котором вес каждой следующей дуги
+// строго больше веса предыдущей. Динамика по дугам, отсортированным по весу, поэтому циклы в графе
+// не мешают, а вершины в пути могут повторяться. Возвращает вершины пути и количество дуг в нем
+func (g *Graph) LongestIncreasingWeightPath() ([]string, int, error) {
+	if !g.is_suspended {
+		return nil, 0, errors.New("Граф должен быть взвешенным")
+	}
+	arcs := g.arcs()
+	length := make([]int, len(arcs)) // длина лучшего пути, оканчивающегося дугой
+	pred := make([]int, len(arcs))   // предыдущая дуга в этом пути или -1
+	best := make(map[*Node]int)      // лучший путь, оканчивающийся в вершине, по уже обработанным весам
+	bestArc := make(map[*Node]int)   // последняя дуга такого пути
+	last := -1                       // последняя дуга самого длинного пути
+
+	for i := 0; i < len(arcs); {
+		// Группа дуг с одинаковым весом не может продолжать друг друга
+		j := i
+		for j < len(arcs) && arcs[j].weight == arcs[i].weight {
+			j++
+		}
+		for k := i; k < j; k++ {
+			length[k] = best[arcs[k].from] + 1
+			pred[k] = -1
+			if best[arcs[k].from] > 0 {
+				pred[k] = bestArc[arcs[k].from]
+			}
+			if last == -1 || length[k] > length[last] {
+				last = k
+			}
+		}
+		for k := i; k < j; k++ {
+			if length[k] > best[arcs[k].to] {
+				best[arcs[k].to] = length[k]
+				bestArc[arcs[k].to] = k
+			}
+		}
+		i = j
+	}
+
+	if last == -1 {
+		return []string{}, 0, nil
+	}
+	path := []string{arcs[last].to.toString()}
+	for k := last; k != -1; k = pred[k] {
+		path = append(path, arcs[k].from.toString())
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, length[last], nil
+}
+
+// cost - возвращает стоимость прохода по связи: ее вес для взвешенного графа и 1 для невзвешенного
+func (g *Graph) cost(weight int) int {
+	if !g.is_suspended {
+		return 1
+	}
+	return weight
+}
+
+// dijkstraItem - элемент очереди с приоритетом для алгоритма Дейкстры
+type dijkstraItem struct {
+	node     *Node
+	distance int
+}
+
+// dijkstraQueue - очередь с приоритетом (куча) для алгоритма Дейкстры,
+// less задает порядок: первой извлекается лучшая метка
+type dijkstraQueue struct {
+	items []dijkstraItem
+	less  func(a, b int) bool
+}
+
+func (q dijkstraQueue) Len() int            { return len(q.items) }
+func (q dijkstraQueue) Less(i, j int) bool  { return q.less(q.items[i].distance, q.items[j].distance) }
+func (q dijkstraQueue) Swap(i, j int)       { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *dijkstraQueue) Push(x interface{}) { q.items = append(q.items, x.(dijkstraItem)) }
+func (q *dijkstraQueue) Pop() interface{} {
+	item := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return item
+}
+
+// dijkstra - алгоритм Дейкстры на куче, находит кратчайшие расстояния от source до достижимых вершин
+// и их предков на кратчайших путях. Веса должны быть неотрицательными, нулевые веса допустимы
+func (g *Graph) dijkstra(source *Node) (map[*Node]int, map[*Node]*Node) {
+	return g.labelSearch(source, 0, func(label, weight int) int {
+		return label + g.cost(weight)
+	}, func(a, b int) bool {
+		return a < b
+	})
+}
+
+// labelSearch - обобщенный алгоритм Дейкстры: метка источника равна start, метка пути, продолженного
+// связью веса weight, вычисляется через extend, а less сравнивает метки (true - первая лучше).
+// extend не должна улучшать метку, иначе результат неверен. Возвращает лучшие метки
+// достижимых вершин и их предков на лучших путях
+func (g *Graph) labelSearch(source *Node, start int, extend func(label, weight int) int, less func(a, b int) bool) (map[*Node]int, map[*Node]*Node) {
+	labels := map[*Node]int{source: start}
+	pred := make(map[*Node]*Node)
+	queue := &dijkstraQueue{[]dijkstraItem{{source, start}}, less}
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(dijkstraItem)
+		if less(labels[current.node], current.distance) {
+			continue // устаревшая запись
+		}
+		for next, w := range g.edges[current.node] {
+			label := extend(current.distance, w)
+			if old, ok := labels[next]; !ok || less(label, old) {
+				labels[next] = label
+				pred[next] = current.node
+				heap.Push(queue, dijkstraItem{next, label})
+			}
+		}
+	}
+	return labels, pred
+}
+
+// pathTo - восстанавливает путь от source до target по словарю предков
+func pathTo(pred map[*Node]*Node, source, target *Node) []string {
+	path := []string{target.toString()}
+	for current := target; current != source; {
+		current = pred[current]
+		path = append(path, current.toString())
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// BottleneckPath - находит путь из from в to, минимизирующий максимальный вес связи на пути
+// (минимаксный путь). Возвращает путь и его узкое место - максимальный вес связи на нем,
+// для пути из вершины в саму себя узкое место равно 0
+func (g *Graph) BottleneckPath(from, to string) ([]string, int, error) {
+	source, target, err := g.weightedEndpoints(from, to)
+	if err != nil {
+		return nil, 0, err
+	}
+	if source == target {
+		return []string{from}, 0, nil
+	}
+	labels, pred := g.labelSearch(source, math.MinInt, func(label, weight int) int {
+		if weight > label {
+			return weight
+		}
+		return label
+	}, func(a, b int) bool {
+		return a < b
+	})
+	if _, ok := labels[target]; !ok {
+		return nil, 0, ErrNoPath
+	}
+	return pathTo(pred, source, target), labels[target], nil
+}
+
+// WidestPath - находит путь из from в to, максимизирующий минимальный вес связи на пути
+// (самый широкий путь, например путь с наибольшей пропускной способностью).
+// Возвращает путь и его ширину - минимальный вес связи на нем, для пути из вершины в саму себя ширина равна 0
+func (g *Graph) WidestPath(from, to string) ([]string, int, error) {
+	source, target, err := g.weightedEndpoints(from, to)
+	if err != nil {
+		return nil, 0, err
+	}
+	if source == target {
+		return []string{from}, 0, nil
+	}
+	labels, pred := g.labelSearch(source, math.MaxInt, func(label, weight int) int {
+		if weight < label {
+			return weight
+		}
+		return label
+	}, func(a, b int) bool {
+		return a > b
+	})
+	if _, ok := labels[target]; !ok {
+		return nil, 0, ErrNoPath
+	}
+	return pathTo(pred, source, target), labels[target], nil
+}
+
+// weightedEndpoints - проверяет, что граф взвешенный и обе вершины существуют, и возвращает их
+func (g *Graph) weightedEndpoints(from, to string) (*Node, *Node, error) {
+	if !g.is_suspended {
+		return nil, nil, errors.New("Граф должен быть взвешенным")
+	}
+	if err := validateNode(g, from); err != nil {
+		return nil, nil, err
+	}
+	if err := validateNode(g, to); err != nil {
+		return nil, nil, err
+	}
+	return g.getRefOfNode(from), g.getRefOfNode(to), nil
+}
+
+// ErrNegativeWeight - ошибка алгоритмов, требующих неотрицательных весов (Дейкстра и основанные на нем)
+var ErrNegativeWeight = errors.New("В графе есть связи отрицательного веса, используйте алгоритм Беллмана-Форда")
+
+// HasNegativeEdge - проверяет, есть ли во взвешенном графе связь отрицательного веса
+// (в невзвешенном графе отрицательных весов нет)
+func (g *Graph) HasNegativeEdge() bool {
+	if !g.is_suspended {
+		return false
+	}
+	for _, v := range g.edges {
+		for _, w := range v {
+			if w < 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AllPairsShortestPaths - находит кратчайшие расстояния и сами пути между всеми парами вершин,
+// запуская алгоритм Дейкстры на куче из каждой вершины (на разреженных графах быстрее Floyd).
+// paths[u][v] - последовательность вершин от u до v, недостижимые пары в словари не попадают,
+// расстояние от вершины до самой себя равно 0. Для невзвешенного графа длина ребра равна 1.
+// Требует неотрицательных весов: если в графе есть связь отрицательного веса, возвращает nil
+// (для таких графов подходят Floyd и BellmanFord)
+func (g *Graph) AllPairsShortestPaths() (map[string]map[string]int, map[string]map[string][]string) {
+	if g.HasNegativeEdge() {
+		return nil, nil
+	}
+	dist := make(map[string]map[string]int, len(g.edges))
+	paths := make(map[string]map[string][]string, len(g.edges))
+	for source := range g.edges {
+		labels, pred := g.dijkstra(source)
+		dist[source.toString()] = make(map[string]int, len(labels))
+		paths[source.toString()] = make(map[string][]string, len(labels))
+		for target, d := range labels {
+			dist[source.toString()][target.toString()] = d
+			paths[source.toString()][target.toString()] = pathTo(pred, source, target)
+		}
+	}
+	return dist, paths
+}
+
+// shortestPath - находит кратчайший путь из from в to и его длину: алгоритмом Дейкстры,
+// а при наличии отрицательных весов - алгоритмом Беллмана-Форда. Если пути нет, возвращает ErrNoPath
+func (g *Graph) shortestPath(from, to string) ([]string, int, error) {
+	if err := validateNode(g, from); err != nil {
+		return nil, 0, err
+	}
+	if err := validateNode(g, to); err != nil {
+		return nil, 0, err
+	}
+	if g.HasNegativeEdge() {
+		path, d, err := g.ShortestPathBF(from, to)
+		if err != nil && err != ErrNegativeCycle {
+			return nil, 0, ErrNoPath
+		}
+		return path, d, err
+	}
+	source, target := g.getRefOfNode(from), g.getRefOfNode(to)
+	dist, pred := g.dijkstra(source)
+	if _, ok := dist[target]; !ok {
+		return nil, 0, ErrNoPath
+	}
+	return pathTo(pred, source, target), dist[target], nil
+}
+
+// ShortestPathAvoiding - находит кратчайший путь из from в to, не проходящий через вершины avoid,
+// и его длину (для невзвешенного графа - количество связей). Исходный граф не изменяется:
+// вершины удаляются из копии. Если какой-то вершины нет, from или to запрещены или пути нет, возвращает ошибку
+func (g *Graph) ShortestPathAvoiding(from, to string, avoid []string) ([]string, int, error) {
+	if err := validateNode(g, from); err != nil {
+		return nil, 0, err
+	}
+	if err := validateNode(g, to); err != nil {
+		return nil, 0, err
+	}
+	workingGraph := NewCopiedGraph(g)
+	for _, value := range avoid {
+		if value == from || value == to {
+			return nil, 0, errors.New("Вершина " + value + " является концом пути и не может быть исключена")
+		}
+		if err := workingGraph.RemoveNode(value); err != nil {
+			return nil, 0, err
+		}
+	}
+	return workingGraph.shortestPath(from, to)
+}
+
+// ShortestPathAvoidingEdges - находит кратчайший путь из from в to, не проходящий по связям forbidden
+// (например, по перекрытым дорогам), и его длину. В неориентированном графе пара запрещает ребро
+// в обоих направлениях, в мультиграфе - все параллельные связи. Исходный граф не изменяется:
+// связи удаляются из копии, отсутствующие связи пропускаются. Если какой-то вершины нет или пути нет, возвращает ошибку
+func (g *Graph) ShortestPathAvoidingEdges(from, to string, forbidden [][2]string) ([]string, int, error) {
+	if err := validateNode(g, from); err != nil {
+		return nil, 0, err
+	}
+	if err := validateNode(g, to); err != nil {
+		return nil, 0, err
+	}
+	workingGraph := NewCopiedGraph(g)
+	for _, e := range forbidden {
+		if _, err := workingGraph.RemoveEdge(e[0], e[1]); err != nil {
+			return nil, 0, err
+		}
+	}
+	return workingGraph.shortestPath(from, to)
+}
+
+// ShortestPathMaxHops - находит кратчайший путь из from в to, состоящий не более чем из k связей
+// (например, маршрут с ограниченным TTL), и его длину. Выполняется k раундов релаксации всех связей,
+// как в алгоритме Беллмана-Форда: после раунда i известны кратчайшие пути не более чем из i связей.
+// Отрицательные веса допускаются, отрицательные циклы на результат не влияют, но путь тогда может
+// проходить вершину повторно. Если вершины нет, k отрицательно или такого пути нет, возвращает ошибку
+func (g *Graph) ShortestPathMaxHops(from, to string, k int) ([]string, int, error) {
+	if err := validateNode(g, from); err != nil {
+		return nil, 0, err
+	}
+	if err := validateNode(g, to); err != nil {
+		return nil, 0, err
+	}
+	if k < 0 {
+		return nil, 0, errors.New("Количество связей не может быть отрицательным")
+	}
+	source, target := g.getRefOfNode(from), g.getRefOfNode(to)
+	// dist[i] - кратчайшие расстояния путями не более чем из i связей,
+	// pred[i][v] - предпоследняя вершина пути до v, если он улучшен в раунде i
+	dist := []map[*Node]int{{source: 0}}
+	pred := []map[*Node]*Node{{}}
+	for i := 1; i <= k; i++ {
+		current := make(map[*Node]int, len(dist[i-1]))
+		for node, d := range dist[i-1] {
+			current[node] = d
+		}
+		improved := make(map[*Node]*Node)
+		for node, d := range dist[i-1] {
+			for next, w := range g.edges[node] {
+				if old, ok := current[next]; !ok || d+g.cost(w) < old {
+					current[next] = d + g.cost(w)
+					improved[next] = node
+				}
+			}
+		}
+		dist = append(dist, current)
+		pred = append(pred, improved)
+		if len(improved) == 0 {
+			break // дальнейшие раунды ничего не изменят
+		}
+	}
+	last := len(dist) - 1
+	if _, ok := dist[last][target]; !ok {
+		return nil, 0, fmt.Errorf("Пути не более чем из %d связей не существует", k)
+	}
+	path := []string{to}
+	for i, current := last, target; i > 0; i-- {
+		if prev, ok := pred[i][current]; ok {
+			current = prev
+			path = append(path, current.toString())
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, dist[last][target], nil
+}
+
+// pathState - состояние поиска второго кратчайшего пути: вершина и номер метки (false - первая, true - вторая)
+type pathState struct {
+	node   *Node
+	second bool
+}
+
+// SecondShortestPath - находит путь из from в to, длина которого строго больше кратчайшей и минимальна
+// среди таких путей, и его длину. Используется алгоритм Дейкстры с двумя метками у каждой вершины:
+// кратчайшее расстояние и наименьшее расстояние, строго большее кратчайшего. Путь может проходить
+// вершины и связи повторно (например, a - b - a - b, если другого пути нет); пути той же длины,
+// что и кратчайший, вторым путем не считаются. Если вершины нет, в графе есть связи отрицательного веса
+// (ErrNegativeWeight) или второго пути нет (ErrNoPath), возвращает ошибку
+func (g *Graph) SecondShortestPath(from, to string) ([]string, int, error) {
+	if err := validateNode(g, from); err != nil {
+		return nil, 0, err
+	}
+	if err := validateNode(g, to); err != nil {
+		return nil, 0, err
+	}
+	if g.HasNegativeEdge() {
+		return nil, 0, ErrNegativeWeight
+	}
+	source, target := g.getRefOfNode(from), g.getRefOfNode(to)
+	start := pathState{source, false}
+	dist := map[pathState]int{start: 0}
+	pred := make(map[pathState]pathState)
+	done := make(map[pathState]bool)
+	for {
+		// Ближайшее необработанное состояние
+		current, found := start, false
+		for state, d := range dist {
+			if !done[state] && (!found || d < dist[current]) {
+				current, found = state, true
+			}
+		}
+		if !found {
+			break
+		}
+		done[current] = true
+		for next, w := range g.edges[current.node] {
+			d := dist[current] + g.cost(w)
+			first, second := pathState{next, false}, pathState{next, true}
+			best, ok := dist[first]
+			switch {
+			case !ok || d < best:
+				if ok {
+					dist[second], pred[second] = best, pred[first]
+				}
+				dist[first], pred[first] = d, current
+			case d > best:
+				if old, ok := dist[second]; !ok || d < old {
+					dist[second], pred[second] = d, current
+				}
+			}
+		}
+	}
+	end := pathState{target, true}
+	if _, ok := dist[end]; !ok {
+		return nil, 0, ErrNoPath
+	}
+	path := []string{to}
+	for state := end; state != start; {
+		state = pred[state]
+		path = append(path, state.node.toString())
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, dist[end], nil
+}
@@ -0,0 +1,320 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// errAny - ожидание любой ошибки в табличных тестах
+var errAny = errors.New("любая ошибка")
+
+// checkErr - сравнивает полученную ошибку с ожидаемой: nil, errAny или конкретная ошибка для errors.Is
+func checkErr(t *testing.T, name string, err, want error) {
+	t.Helper()
+	switch {
+	case want == nil && err != nil, want != nil && err == nil:
+		t.Fatalf("%s error = %v, want %v", name, err, want)
+	case want != nil && want != errAny && !errors.Is(err, want):
+		t.Fatalf("%s error = %v, want %v", name, err, want)
+	}
+}
+
+func TestLongestIncreasingWeightPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		oriented   bool
+		weighted   bool
+		edges      [][3]string
+		want       []string
+		wantLength int
+		wantErr    bool
+	}{
+		{"путь через цикл", true, true,
+			[][3]string{{"a", "b", "1"}, {"b", "c", "2"}, {"c", "a", "3"}, {"a", "d", "4"}, {"b", "d", "9"}},
+			[]string{"a", "b", "c", "a", "d"}, 4, false},
+		{"равные веса не продолжают путь", true, true, [][3]string{{"a", "b", "1"}, {"b", "c", "1"}}, []string{"a", "b"}, 1, false},
+		{"неориентированный граф", false, true, [][3]string{{"a", "b", "1"}, {"b", "c", "2"}}, []string{"a", "b", "c"}, 2, false},
+		{"граф без связей", true, true, nil, []string{}, 0, false},
+		{"невзвешенный граф", true, false, [][3]string{{"a", "b"}}, nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, length, err := mustGraph(t, tt.oriented, tt.weighted, tt.edges).LongestIncreasingWeightPath()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LongestIncreasingWeightPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) || length != tt.wantLength {
+				t.Errorf("LongestIncreasingWeightPath() = %v, %d, want %v, %d", got, length, tt.want, tt.wantLength)
+			}
+		})
+	}
+}
+
+func TestBottleneckPath(t *testing.T) {
+	// Кратчайший по сумме путь a - b - d (11) проходит по связи веса 10,
+	// минимаксный путь a - c - e - d длиннее (12), но его наибольший вес 4
+	network := [][3]string{{"a", "b", "10"}, {"b", "d", "1"}, {"a", "c", "4"}, {"c", "e", "4"}, {"e", "d", "4"}, {"f", "a", "1"}}
+	tests := []struct {
+		name     string
+		weighted bool
+		from, to string
+		want     []string
+		wantNeck int
+		wantErr  error
+	}{
+		{"минимаксный путь", true, "a", "d", []string{"a", "c", "e", "d"}, 4, nil},
+		{"путь из вершины в себя", true, "a", "a", []string{"a"}, 0, nil},
+		{"нет пути против дуги", true, "a", "f", nil, 0, ErrNoPath},
+		{"нет вершины", true, "a", "x", nil, 0, errAny},
+		{"невзвешенный граф", false, "a", "d", nil, 0, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, true, tt.weighted, network)
+			got, neck, err := g.BottleneckPath(tt.from, tt.to)
+			checkErr(t, "BottleneckPath()", err, tt.wantErr)
+			if !reflect.DeepEqual(got, tt.want) || neck != tt.wantNeck {
+				t.Errorf("BottleneckPath() = %v, %d, want %v, %d", got, neck, tt.want, tt.wantNeck)
+			}
+			if tt.wantErr == nil && tt.from != tt.to {
+				if _, sum, _ := g.shortestPath(tt.from, tt.to); sum != 11 {
+					t.Errorf("кратчайший по сумме путь = %d, want 11", sum)
+				}
+			}
+		})
+	}
+}
+
+func TestWidestPath(t *testing.T) {
+	// Короткий путь a - b - d имеет ширину 2, более длинный a - c - e - d - ширину 7
+	network := [][3]string{{"a", "b", "2"}, {"b", "d", "2"}, {"a", "c", "9"}, {"c", "e", "8"}, {"e", "d", "7"}, {"f", "a", "5"}}
+	tests := []struct {
+		name      string
+		oriented  bool
+		weighted  bool
+		from, to  string
+		want      []string
+		wantWidth int
+		wantErr   error
+	}{
+		{"самый широкий путь", true, true, "a", "d", []string{"a", "c", "e", "d"}, 7, nil},
+		{"неориентированный граф", false, true, "d", "f", []string{"d", "e", "c", "a", "f"}, 5, nil},
+		{"путь из вершины в себя", true, true, "c", "c", []string{"c"}, 0, nil},
+		{"нет пути против дуги", true, true, "d", "a", nil, 0, ErrNoPath},
+		{"нет вершины", true, true, "x", "a", nil, 0, errAny},
+		{"невзвешенный граф", true, false, "a", "d", nil, 0, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, width, err := mustGraph(t, tt.oriented, tt.weighted, network).WidestPath(tt.from, tt.to)
+			checkErr(t, "WidestPath()", err, tt.wantErr)
+			if !reflect.DeepEqual(got, tt.want) || width != tt.wantWidth {
+				t.Errorf("WidestPath() = %v, %d, want %v, %d", got, width, tt.want, tt.wantWidth)
+			}
+		})
+	}
+}
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *Graph
+	}{
+		{"ориентированный взвешенный", mustGraph(t, true, true, [][3]string{{"a", "b", "4"}, {"a", "c", "1"}, {"c", "b", "2"}, {"b", "d", "5"}, {"e", "a", "0"}})},
+		{"решетка", NewGridGraph(3, 4)},
+		{"случайный взвешенный", NewRandomWeightedGraph(25, 20, 0.15, true, rand.New(rand.NewSource(9)))},
+		{"случайный неориентированный", NewRandomWeightedGraph(25, 20, 0.1, false, rand.New(rand.NewSource(10)))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dist, paths := tt.g.AllPairsShortestPaths()
+			floyd, _ := tt.g.Floyd()
+			if !reflect.DeepEqual(dist, floyd) {
+				t.Fatalf("AllPairsShortestPaths() = %v, Floyd() = %v", dist, floyd)
+			}
+			// Каждый путь ведет из u в v по существующим связям и имеет длину dist[u][v]
+			for u, targets := range paths {
+				for v, path := range targets {
+					if path[0] != u || path[len(path)-1] != v {
+						t.Fatalf("путь %s - %s = %v", u, v, path)
+					}
+					length := 0
+					for i := 1; i < len(path); i++ {
+						w, ok := tt.g.edgeWeight(path[i-1], path[i])
+						if !ok {
+							t.Fatalf("путь %v проходит по отсутствующей связи %s - %s", path, path[i-1], path[i])
+						}
+						length += tt.g.cost(w)
+					}
+					if length != dist[u][v] {
+						t.Errorf("длина пути %v = %d, want %d", path, length, dist[u][v])
+					}
+				}
+			}
+		})
+	}
+	g := mustGraph(t, true, true, [][3]string{{"a", "b", "-1"}})
+	if dist, paths := g.AllPairsShortestPaths(); dist != nil || paths != nil {
+		t.Errorf("AllPairsShortestPaths() с отрицательным весом = %v, %v, want nil", dist, paths)
+	}
+}
+
+// roads - неориентированная сеть с тремя путями из a в d разной длины
+var roads = [][3]string{{"a", "b", "1"}, {"b", "d", "1"}, {"a", "c", "2"}, {"c", "d", "2"}, {"a", "e", "10"}, {"e", "d", "10"}}
+
+func TestShortestPathAvoiding(t *testing.T) {
+	tests := []struct {
+		name       string
+		oriented   bool
+		weighted   bool
+		edges      [][3]string
+		avoid      []string
+		want       []string
+		wantLength int
+		wantErr    error
+	}{
+		{"без ограничений", false, true, roads, nil, []string{"a", "b", "d"}, 2, nil},
+		{"в обход b", false, true, roads, []string{"b"}, []string{"a", "c", "d"}, 4, nil},
+		{"в обход b и c", false, true, roads, []string{"c", "b"}, []string{"a", "e", "d"}, 20, nil},
+		{"все пути перекрыты", false, true, roads, []string{"b", "c", "e"}, nil, 0, ErrNoPath},
+		{"невзвешенный граф", false, false, roads, []string{"b", "c"}, []string{"a", "e", "d"}, 2, nil},
+		{"отрицательный вес в орграфе", true, true, [][3]string{{"a", "b", "1"}, {"b", "d", "1"}, {"a", "c", "4"}, {"c", "d", "-3"}}, []string{"b"},
+			[]string{"a", "c", "d"}, 1, nil},
+		{"конец пути запрещен", false, true, roads, []string{"d"}, nil, 0, errAny},
+		{"нет вершины", false, true, roads, []string{"x"}, nil, 0, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, tt.weighted, tt.edges)
+			before := NewCopiedGraph(g)
+			got, length, err := g.ShortestPathAvoiding("a", "d", tt.avoid)
+			checkErr(t, "ShortestPathAvoiding()", err, tt.wantErr)
+			if !reflect.DeepEqual(got, tt.want) || length != tt.wantLength {
+				t.Errorf("ShortestPathAvoiding() = %v, %d, want %v, %d", got, length, tt.want, tt.wantLength)
+			}
+			if !g.Equal(before) {
+				t.Error("ShortestPathAvoiding() изменил исходный граф")
+			}
+		})
+	}
+}
+
+func TestShortestPathAvoidingEdges(t *testing.T) {
+	tests := []struct {
+		name       string
+		oriented   bool
+		forbidden  [][2]string
+		want       []string
+		wantLength int
+		wantErr    error
+	}{
+		{"без ограничений", false, nil, []string{"a", "b", "d"}, 2, nil},
+		{"перекрыта дорога b-d", false, [][2]string{{"b", "d"}}, []string{"a", "c", "d"}, 4, nil},
+		{"ребро запрещено в обе стороны", false, [][2]string{{"d", "b"}}, []string{"a", "c", "d"}, 4, nil},
+		{"дуга запрещена в одну сторону", true, [][2]string{{"d", "b"}}, []string{"a", "b", "d"}, 2, nil},
+		{"перекрыты две дороги", false, [][2]string{{"a", "b"}, {"c", "d"}}, []string{"a", "e", "d"}, 20, nil},
+		{"отсутствующая связь пропускается", false, [][2]string{{"b", "c"}}, []string{"a", "b", "d"}, 2, nil},
+		{"все пути перекрыты", false, [][2]string{{"a", "b"}, {"a", "c"}, {"a", "e"}}, nil, 0, ErrNoPath},
+		{"нет вершины", false, [][2]string{{"a", "x"}}, nil, 0, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustGraph(t, tt.oriented, true, roads)
+			before := NewCopiedGraph(g)
+			got, length, err := g.ShortestPathAvoidingEdges("a", "d", tt.forbidden)
+			checkErr(t, "ShortestPathAvoidingEdges()", err, tt.wantErr)
+			if !reflect.DeepEqual(got, tt.want) || length != tt.wantLength {
+				t.Errorf("ShortestPathAvoidingEdges() = %v, %d, want %v, %d", got, length, tt.want, tt.wantLength)
+			}
+			if !g.Equal(before) {
+				t.Error("ShortestPathAvoidingEdges() изменил исходный граф")
+			}
+		})
+	}
+	// В мультиграфе пара запрещает все параллельные связи
+	g := NewEmptyMultigraph()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "b", 5)
+	g.AddEdge("a", "c", 3)
+	g.AddEdge("c", "b", 3)
+	got, length, err := g.ShortestPathAvoidingEdges("a", "b", [][2]string{{"a", "b"}})
+	if err != nil || !reflect.DeepEqual(got, []string{"a", "c", "b"}) || length != 6 {
+		t.Errorf("ShortestPathAvoidingEdges() в мультиграфе = %v, %d, %v, want [a c b], 6, <nil>", got, length, err)
+	}
+}
+
+func TestShortestPathMaxHops(t *testing.T) {
+	// Кратчайший путь a - b - c - d длиной 3 состоит из трех дуг, более длинные пути короче по числу дуг
+	hops := [][3]string{{"a", "b", "1"}, {"b", "c", "1"}, {"c", "d", "1"}, {"a", "c", "5"}, {"a", "d", "10"}}
+	negative := [][3]string{{"a", "b", "4"}, {"a", "c", "1"}, {"c", "b", "-2"}}
+	tests := []struct {
+		name       string
+		weighted   bool
+		edges      [][3]string
+		from, to   string
+		k          int
+		want       []string
+		wantLength int
+		wantErr    error
+	}{
+		{"ограничение не мешает", true, hops, "a", "d", 3, []string{"a", "b", "c", "d"}, 3, nil},
+		{"ограничение больше нужного", true, hops, "a", "d", 10, []string{"a", "b", "c", "d"}, 3, nil},
+		{"не более двух дуг", true, hops, "a", "d", 2, []string{"a", "c", "d"}, 6, nil},
+		{"одна дуга", true, hops, "a", "d", 1, []string{"a", "d"}, 10, nil},
+		{"невзвешенный граф", false, hops, "a", "d", 3, []string{"a", "d"}, 1, nil},
+		{"отрицательный вес", true, negative, "a", "b", 2, []string{"a", "c", "b"}, -1, nil},
+		{"отрицательный вес за пределом", true, negative, "a", "b", 1, []string{"a", "b"}, 4, nil},
+		{"путь из вершины в себя", true, hops, "a", "a", 0, []string{"a"}, 0, nil},
+		{"нет пути из k дуг", true, hops, "b", "d", 1, nil, 0, errAny},
+		{"нет пути против дуги", true, hops, "d", "a", 5, nil, 0, errAny},
+		{"отрицательное k", true, hops, "a", "d", -1, nil, 0, errAny},
+		{"нет вершины", true, hops, "a", "x", 3, nil, 0, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, length, err := mustGraph(t, true, tt.weighted, tt.edges).ShortestPathMaxHops(tt.from, tt.to, tt.k)
+			checkErr(t, "ShortestPathMaxHops()", err, tt.wantErr)
+			if !reflect.DeepEqual(got, tt.want) || length != tt.wantLength {
+				t.Errorf("ShortestPathMaxHops() = %v, %d, want %v, %d", got, length, tt.want, tt.wantLength)
+			}
+			if err == nil && len(got)-1 > tt.k {
+				t.Errorf("путь %v длиннее %d дуг", got, tt.k)
+			}
+		})
+	}
+}
+
+func TestSecondShortestPath(t *testing.T) {
+	detour := [][3]string{{"a", "b", "1"}, {"b", "d", "1"}, {"a", "c", "2"}, {"c", "d", "1"}}
+	ties := [][3]string{{"a", "b", "1"}, {"b", "d", "1"}, {"a", "c", "1"}, {"c", "d", "1"}, {"a", "d", "5"}}
+	tests := []struct {
+		name       string
+		oriented   bool
+		weighted   bool
+		edges      [][3]string
+		from, to   string
+		want       []string
+		wantLength int
+		wantErr    error
+	}{
+		{"объезд", true, true, detour, "a", "d", []string{"a", "c", "d"}, 3, nil},
+		{"пути той же длины не считаются", true, true, ties, "a", "d", []string{"a", "d"}, 5, nil},
+		{"невзвешенный граф", true, false, [][3]string{{"a", "b"}, {"b", "d"}, {"a", "d"}}, "a", "d", []string{"a", "b", "d"}, 2, nil},
+		{"повторный проход по ребру", false, true, [][3]string{{"a", "b", "1"}}, "a", "b", []string{"a", "b", "a", "b"}, 3, nil},
+		{"путь из вершины в себя", false, true, [][3]string{{"a", "b", "1"}}, "a", "a", []string{"a", "b", "a"}, 2, nil},
+		{"единственный путь в орграфе", true, true, [][3]string{{"a", "b", "1"}, {"b", "c", "1"}}, "a", "c", nil, 0, ErrNoPath},
+		{"отрицательный вес", true, true, [][3]string{{"a", "b", "-1"}, {"b", "c", "1"}}, "a", "c", nil, 0, ErrNegativeWeight},
+		{"нет вершины", true, true, detour, "a", "x", nil, 0, errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, length, err := mustGraph(t, tt.oriented, tt.weighted, tt.edges).SecondShortestPath(tt.from, tt.to)
+			checkErr(t, "SecondShortestPath()", err, tt.wantErr)
+			if !reflect.DeepEqual(got, tt.want) || length != tt.wantLength {
+				t.Errorf("SecondShortestPath() = %v, %d, want %v, %d", got, length, tt.want, tt.wantLength)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+)
+
+/*
+
+Достижимость вершин:
+- ReachableWithinWeight - вершины, достижимые по связям с ограниченным весом
+- ReachableCounts - количество вершин, достижимых из каждой вершины
+- Reachable - вершины, достижимые из данной
+
+*/
+
+// reachableFrom - обходом в ширину находит вершины, достижимые из start по связям,
+// для которых allowed возвращает true (включая саму start)
+func (g *Graph) reachableFrom(start *Node, allowed func(from, to *Node, weight int) bool) map[*Node]bool {
+	visited := map[*Node]bool{start: true}
+	queue := []*Node{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for next, w := range g.edges[current] {
+			if !visited[next] && allowed(current, next, w) {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return visited
+}
+
+// ReachableWithinWeight - возвращает отсортированный список вершин (включая from), достижимых из from
+// только по дугам / ребрам, вес каждого из которых не больше maxEdgeWeight (сумма весов не ограничивается)
+func (g *Graph) ReachableWithinWeight(from string, maxEdgeWeight int) ([]string, error) {
+	if !g.is_suspended {
+		return nil, errors.New("Граф должен быть взвешенным")
+	}
+	if err := validateNode(g, from); err != nil {
+		return nil, err
+	}
+	return sortedValues(g.reachableFrom(g.getRefOfNode(from), func(_, _ *Node, weight int) bool {
+		return weight <= maxEdgeWeight
+	})), nil
+}
+
+// sortedValues - возвращает отсортированные значения узлов множества
+func sortedValues(nodes map[*Node]bool) []string {
+	result := make([]string, 0, len(nodes))
+	for n := range nodes {
+		result = append(result, n.toString())
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ReachableCounts - возвращает для каждой вершины количество достижимых из нее вершин (включая ее саму).
+// Выполняет обход в ширину из каждой вершины, сложность O(V * (V + E))
+func (g *Graph) ReachableCounts() map[string]int {
+	result := make(map[string]int, len(g.edges))
+	for node := range g.edges {
+		result[node.toString()] = len(g.reachableFrom(node, func(_, _ *Node, _ int) bool {
+			return true
+		}))
+	}
+	return result
+}
+
+// Reachable - возвращает отсортированный список вершин, достижимых из from с учетом направления дуг,
+// включая саму from
+func (g *Graph) Reachable(from string) ([]string, error) {
+	if err := validateNode(g, from); err != nil {
+		return nil, err
+	}
+	return sortedValues(g.reachableFrom(g.getRefOfNode(from), func(_, _ *Node, _ int) bool {
+		return true
+	})), nil
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReachableWithinWeight(t *testing.T) {
+	network := [][3]string{{"a", "b", "2"}, {"b", "c", "5"}, {"a", "d", "8"}, {"c", "e", "1"}}
+	tests := []struct {
+		name      string
+		weighted  bool
+		from      string
+		threshold int
+		want      []string
+		wantErr   bool
+	}{
+		{"только дешевые связи", true, "a", 2, []string{"a", "b"}, false},
+		{"порог открывает c и e", true, "a", 5, []string{"a", "b", "c", "e"}, false},
+		{"все связи", true, "a", 8, []string{"a", "b", "c", "d", "e"}, false},
+		{"порог ниже всех весов", true, "a", 0, []string{"a"}, false},
+		{"нет вершины", true, "x", 5, nil, true},
+		{"невзвешенный граф", false, "a", 5, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustGraph(t, false, tt.weighted, network).ReachableWithinWeight(tt.from, tt.threshold)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReachableWithinWeight() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReachableWithinWeight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReachableCounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		oriented bool
+		edges    [][3]string
+		want     map[string]int
+	}{
+		{"ориентированная цепочка", true, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "d"}},
+			map[string]int{"a": 4, "b": 3, "c": 2, "d": 1}},
+		{"цикл и хвост", true, [][3]string{{"a", "b"}, {"b", "a"}, {"b", "c"}},
+			map[string]int{"a": 3, "b": 3, "c": 1}},
+		{"неориентированная цепочка", false, [][3]string{{"a", "b"}, {"b", "c"}},
+			map[string]int{"a": 3, "b": 3, "c": 3}},
+		{"пустой граф", true, nil, map[string]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustGraph(t, tt.oriented, false, tt.edges).ReachableCounts(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReachableCounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+/*
+
+Спектральные характеристики графа (по матрице смежности):
+- EstradaIndex - индекс Эстрады
+- SubgraphCentrality - центральность вершин по подграфам
+- SpectralRadius - спектральный радиус
+- NumberOfSpanningTrees - количество остовных деревьев (матричная теорема о деревьях)
+
+*/
+
+// binaryAdjacency - возвращает отсортированные значения узлов и матрицу смежности из нулей и единиц
+// (веса не учитываются)
+func (g *Graph) binaryAdjacency() ([]string, [][]float64) {
+	values := g.nodeValues()
+	index := make(map[string]int, len(values))
+	for i, value := range values {
+		index[value] = i
+	}
+	matrix := make([][]float64, len(values))
+	for i := range matrix {
+		matrix[i] = make([]float64, len(values))
+	}
+	for node, v := range g.edges {
+		for next := range v {
+			matrix[index[node.toString()]][index[next.toString()]] = 1
+		}
+	}
+	return values, matrix
+}
+
+// jacobiEigen - находит собственные значения и собственные векторы (столбцы vectors)
+// симметричной матрицы методом вращений Якоби. Исходная матрица не изменяется
+func jacobiEigen(matrix [][]float64) ([]float64, [][]float64) {
+	n := len(matrix)
+	a := make([][]float64, n)
+	vectors := make([][]float64, n)
+	for i := range matrix {
+		a[i] = append([]float64{}, matrix[i]...)
+		vectors[i] = make([]float64, n)
+		vectors[i][i] = 1
+	}
+	for sweep := 0; sweep < 100; sweep++ {
+		// Сумма квадратов внедиагональных элементов
+		off := 0.0
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += a[i][j] * a[i][j]
+			}
+		}
+		if off < 1e-22 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if math.Abs(a[p][q]) < 1e-15 {
+					continue
+				}
+				// Вращение, обнуляющее a[p][q]
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := vectors[k][p], vectors[k][q]
+					vectors[k][p] = c*vkp - s*vkq
+					vectors[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = a[i][i]
+	}
+	return values, vectors
+}
+
+// EstradaIndex - возвращает индекс Эстрады неориентированного графа - сумму экспонент собственных значений
+// матрицы смежности (след матричной экспоненты). Веса не учитываются, собственные значения находятся
+// методом Якоби, что подходит для небольших графов
+func (g *Graph) EstradaIndex() (float64, error) {
+	if g.is_oriented {
+		return 0, errors.New("Граф должен быть неориентированным")
+	}
+	_, matrix := g.binaryAdjacency()
+	eigenvalues, _ := jacobiEigen(matrix)
+	sum := 0.0
+	for _, lambda := range eigenvalues {
+		sum += math.Exp(lambda)
+	}
+	return sum, nil
+}
+
+// SubgraphCentrality - возвращает для каждой вершины неориентированного графа ее центральность по подграфам -
+// диагональный элемент экспоненты матрицы смежности, то есть взвешенное число замкнутых маршрутов
+// через вершину (короткие маршруты весят больше). Вычисляется через спектральное разложение,
+// сумма значений по всем вершинам равна индексу Эстрады
+func (g *Graph) SubgraphCentrality() (map[string]float64, error) {
+	if g.is_oriented {
+		return nil, errors.New("Граф должен быть неориентированным")
+	}
+	values, matrix := g.binaryAdjacency()
+	eigenvalues, vectors := jacobiEigen(matrix)
+	result := make(map[string]float64, len(values))
+	for i, value := range values {
+		sum := 0.0
+		for j, lambda := range eigenvalues {
+			sum += vectors[i][j] * vectors[i][j] * math.Exp(lambda)
+		}
+		result[value] = sum
+	}
+	return result, nil
+}
+
+// SpectralRadius - возвращает спектральный радиус графа - наибольшее собственное значение матрицы смежности,
+// оцененное степенным методом. Итерации выполняются для матрицы A + I, чтобы у двудольных графов
+// не возникало колебаний между собственными значениями λ и -λ. Веса не учитываются.
+// Для орграфа сходимость может быть медленной, поэтому число итераций ограничено
+func (g *Graph) SpectralRadius() (float64, error) {
+	if len(g.edges) == 0 {
+		return 0, errors.New("Граф не содержит вершин")
+	}
+	_, matrix := g.binaryAdjacency()
+	n := len(matrix)
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / math.Sqrt(float64(n))
+	}
+	lambda := 0.0
+	for iteration := 0; iteration < 10000; iteration++ {
+		// y = (A + I) x
+		y := make([]float64, n)
+		norm := 0.0
+		for i := 0; i < n; i++ {
+			y[i] = x[i]
+			for j := 0; j < n; j++ {
+				y[i] += matrix[i][j] * x[j]
+			}
+			norm += y[i] * y[i]
+		}
+		norm = math.Sqrt(norm)
+		for i := range y {
+			y[i] /= norm
+		}
+		x = y
+		if math.Abs(norm-lambda) < 1e-12 {
+			lambda = norm
+			break
+		}
+		lambda = norm
+	}
+	return lambda - 1, nil
+}
+
+// NumberOfSpanningTrees - возвращает количество остовных деревьев неориентированного графа по матричной теореме
+// Кирхгофа: оно равно определителю матрицы Кирхгофа (степени на диагонали, минус число связей между вершинами
+// вне диагонали) без первой строки и первого столбца. Веса не учитываются, параллельные связи мультиграфа
+// считаются различными, петли пропускаются. Определитель вычисляется точно (алгоритм Барейса над big.Int),
+// поэтому переполнения нет. Для несвязного графа результат равен 0, для графа из одной вершины - 1,
+// для пустого графа - 0. Для орграфа возвращает ошибку
+func (g *Graph) NumberOfSpanningTrees() (*big.Int, error) {
+	if g.is_oriented {
+		return nil, errors.New("Граф должен быть неориентированным")
+	}
+	values := g.nodeValues()
+	if len(values) == 0 {
+		return big.NewInt(0), nil
+	}
+	index := make(map[*Node]int, len(values))
+	for i, value := range values {
+		index[g.getRefOfNode(value)] = i
+	}
+	// Минор матрицы Кирхгофа без вершины с индексом 0
+	n := len(values) - 1
+	minor := make([][]*big.Int, n)
+	for i := range minor {
+		minor[i] = make([]*big.Int, n)
+		for j := range minor[i] {
+			minor[i][j] = new(big.Int)
+		}
+	}
+	for node, v := range g.linkCounts() {
+		i := index[node] - 1
+		if i < 0 {
+			continue
+		}
+		for next, count := range v {
+			j := index[next] - 1
+			minor[i][i].Add(minor[i][i], big.NewInt(int64(count)))
+			if j >= 0 {
+				minor[i][j].Sub(minor[i][j], big.NewInt(int64(count)))
+			}
+		}
+	}
+	return bareissDeterminant(minor), nil
+}
+
+// bareissDeterminant - вычисляет определитель целочисленной матрицы алгоритмом Барейса:
+// все промежуточные деления выполняются нацело, поэтому результат точный. Матрица изменяется.
+// Определитель матрицы размера 0 равен 1
+func bareissDeterminant(a [][]*big.Int) *big.Int {
+	n := len(a)
+	sign := 1
+	previous := big.NewInt(1)
+	for k := 0; k < n; k++ {
+		// Ненулевой ведущий элемент, при необходимости - перестановка строк
+		if a[k][k].Sign() == 0 {
+			swap := -1
+			for i := k + 1; i < n; i++ {
+				if a[i][k].Sign() != 0 {
+					swap = i
+					break
+				}
+			}
+			if swap == -1 {
+				return big.NewInt(0)
+			}
+			a[k], a[swap] = a[swap], a[k]
+			sign = -sign
+		}
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				// a[i][j] = (a[i][j] * a[k][k] - a[i][k] * a[k][j]) / previous
+				t := new(big.Int).Mul(a[i][j], a[k][k])
+				t.Sub(t, new(big.Int).Mul(a[i][k], a[k][j]))
+				a[i][j] = t.Quo(t, previous)
+			}
+		}
+		previous = a[k][k]
+	}
+	if n == 0 {
+		return big.NewInt(1)
+	}
+	result := new(big.Int).Set(a[n-1][n-1])
+	if sign < 0 {
+		result.Neg(result)
+	}
+	return result
+}
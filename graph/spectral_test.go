@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+// spectralTolerance - допустимая погрешность спектральных вычислений
+const spectralTolerance = 1e-9
+
+func TestEstradaIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *Graph
+		want    float64
+		wantErr bool
+	}{
+		{"одно ребро", NewPathGraph(2), math.E + 1/math.E, false},
+		{"треугольник", NewCycleGraph(3), math.Exp(2) + 2/math.E, false},
+		{"звезда с тремя листьями", starGraph(t, "a", "b", "d"), math.Exp(math.Sqrt(3)) + math.Exp(-math.Sqrt(3)) + 2, false},
+		{"изолированная вершина", NewPathGraph(1), 1, false},
+		{"орграф", mustGraph(t, true, false, [][3]string{{"a", "b"}}), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.EstradaIndex()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EstradaIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > spectralTolerance {
+				t.Errorf("EstradaIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubgraphCentrality(t *testing.T) {
+	tests := []struct {
+		name     string
+		g        *Graph
+		wantBest string
+		want     map[string]float64
+		wantErr  bool
+	}{
+		{"звезда", starGraph(t, "a", "b", "d", "e"), "c", nil, false},
+		{"треугольник с хвостом", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}}), "c", nil, false},
+		{"одно ребро", NewPathGraph(2), "", map[string]float64{"0": math.Cosh(1), "1": math.Cosh(1)}, false},
+		{"орграф", mustGraph(t, true, false, [][3]string{{"a", "b"}}), "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.SubgraphCentrality()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SubgraphCentrality() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			for value, want := range tt.want {
+				if math.Abs(got[value]-want) > spectralTolerance {
+					t.Errorf("SubgraphCentrality()[%s] = %v, want %v", value, got[value], want)
+				}
+			}
+			if tt.wantBest != "" {
+				for value, c := range got {
+					if value != tt.wantBest && c >= got[tt.wantBest] {
+						t.Errorf("SubgraphCentrality()[%s] = %v не меньше, чем у %s (%v)", value, c, tt.wantBest, got[tt.wantBest])
+					}
+				}
+			}
+			// Сумма по вершинам равна индексу Эстрады
+			sum := 0.0
+			for _, c := range got {
+				sum += c
+			}
+			if estrada, _ := tt.g.EstradaIndex(); math.Abs(sum-estrada) > spectralTolerance {
+				t.Errorf("сумма центральностей %v, индекс Эстрады %v", sum, estrada)
+			}
+		})
+	}
+}
+
+func TestSpectralRadius(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *Graph
+		want    float64
+		wantErr bool
+	}{
+		{"полный граф K5", NewCompleteGraph([]string{"a", "b", "c", "d", "e"}), 4, false},
+		{"цикл как 2-регулярный граф", NewCycleGraph(7), 2, false},
+		{"четный (двудольный) цикл", NewCycleGraph(6), 2, false},
+		{"звезда", starGraph(t, "a", "b", "d", "e"), 2, false},
+		{"изолированная вершина", NewPathGraph(1), 0, false},
+		{"пустой граф", NewEmptyGraph(), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.SpectralRadius()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SpectralRadius() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("SpectralRadius() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumberOfSpanningTrees(t *testing.T) {
+	// names - вершины "0", ..., "n-1" полного графа
+	names := func(n int) []string {
+		result := make([]string, n)
+		for i := range result {
+			result[i] = strconv.Itoa(i)
+		}
+		return result
+	}
+	// pow - n в степени k
+	pow := func(n, k int64) *big.Int {
+		return new(big.Int).Exp(big.NewInt(n), big.NewInt(k), nil)
+	}
+	multigraph := NewEmptyMultigraph()
+	multigraph.is_oriented = false
+	multigraph.AddEdge("a", "b", 1)
+	multigraph.AddEdge("a", "b", 2)
+	multigraph.AddEdge("b", "c", 1)
+	multigraph.AddEdge("c", "c", 1)
+	disconnected := NewPathGraph(3)
+	disconnected.AddNode("z")
+	single := NewEmptyGraph()
+	single.is_oriented = false
+	single.AddNode("a")
+	empty := NewEmptyGraph()
+	empty.is_oriented = false
+
+	tests := []struct {
+		name    string
+		g       *Graph
+		want    *big.Int
+		wantErr bool
+	}{
+		{"K_4 - формула Кэли", NewCompleteGraph(names(4)), pow(4, 2), false},
+		{"K_30 - без переполнения", NewCompleteGraph(names(30)), pow(30, 28), false},
+		{"цикл на 7 вершинах", NewCycleGraph(7), big.NewInt(7), false},
+		{"решетка 2 x 3", NewGridGraph(2, 3), big.NewInt(15), false},
+		{"путь - единственное дерево", NewPathGraph(5), big.NewInt(1), false},
+		{"параллельные связи различны, петля пропускается", multigraph, big.NewInt(2), false},
+		{"несвязный граф", disconnected, big.NewInt(0), false},
+		{"одна вершина", single, big.NewInt(1), false},
+		{"пустой граф", empty, big.NewInt(0), false},
+		{"орграф", NewEmptyGraph(), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.NumberOfSpanningTrees()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NumberOfSpanningTrees() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Cmp(tt.want) != 0 {
+				t.Errorf("NumberOfSpanningTrees() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package graph
+
+/*
+
+Общие характеристики графа:
+- Density - плотность графа
+- Stats - сводка основных характеристик
+
+*/
+
+// Stats - сводка характеристик графа
+type Stats struct {
+	Nodes     int     // количество вершин
+	Edges     int     // количество связей (ребро неориентированного графа и параллельные связи считаются один раз)
+	Density   float64 // плотность (см. Density)
+	MinDegree int     // наименьшая степень вершины
+	MaxDegree int     // наибольшая степень вершины
+	AvgDegree float64 // средняя степень вершины
+	Connected bool    // связен ли граф (для орграфа - слабо)
+}
+
+// linkCount - возвращает количество связей графа: пары смежных вершин без учета параллельных связей,
+// ребро неориентированного графа, хранящееся в обоих направлениях, считается один раз
+func (g *Graph) linkCount() int {
+	count := 0
+	for node, v := range g.edges {
+		for next := range v {
+			if g.is_oriented || node.toString() <= next.toString() {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Density - возвращает плотность графа - отношение количества связей (см. Stats.Edges) к наибольшему
+// возможному: n(n-1) для орграфа и n(n-1)/2 для неориентированного графа. Места для n петель добавляются,
+// только если в графе есть петля (петли по умолчанию разрешены, но граф без петель, например полный граф
+// NewCompleteGraph, должен иметь плотность 1). Если связей быть не может (например, у графа нет вершин), возвращает 0
+func (g *Graph) Density() float64 {
+	n := len(g.edges)
+	possible := n * (n - 1)
+	if !g.is_oriented {
+		possible /= 2
+	}
+	if !g.no_self_loops && g.hasSelfLoops() {
+		possible += n
+	}
+	if possible == 0 {
+		return 0
+	}
+	return float64(g.linkCount()) / float64(possible)
+}
+
+// hasSelfLoops - проверяет, есть ли в графе хотя бы одна петля
+func (g *Graph) hasSelfLoops() bool {
+	for node, v := range g.edges {
+		if _, ok := v[node]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Stats - возвращает сводку характеристик графа: количество вершин и связей, плотность, наименьшую,
+// наибольшую и среднюю степень (в смысле Degree) и связность. Для пустого графа степени равны 0,
+// а граф считается связным
+func (g *Graph) Stats() Stats {
+	result := Stats{
+		Nodes:     len(g.edges),
+		Edges:     g.linkCount(),
+		Density:   g.Density(),
+		Connected: g.IsConnected(),
+	}
+	sum := 0
+	for i, degree := range g.DegreeSequence() {
+		if i == 0 {
+			result.MaxDegree = degree
+		}
+		result.MinDegree = degree
+		sum += degree
+	}
+	if result.Nodes > 0 {
+		result.AvgDegree = float64(sum) / float64(result.Nodes)
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	forbidden := NewPathGraph(3)
+	forbidden.AllowSelfLoops(false)
+	multigraph := NewEmptyMultigraph()
+	multigraph.is_oriented = false
+	multigraph.AddEdge("a", "b", 1)
+	multigraph.AddEdge("a", "b", 2)
+	single := NewEmptyGraph()
+	single.AddNode("a")
+	tests := []struct {
+		name string
+		g    *Graph
+		want Stats
+	}{
+		{"K_3 без петель", NewCompleteGraph([]string{"a", "b", "c"}), Stats{3, 3, 1, 2, 2, 2, true}},
+		{"ребро хранится дважды, считается один раз", NewPathGraph(4), Stats{4, 3, 0.5, 1, 2, 1.5, true}},
+		{"орграф", mustGraph(t, true, false, [][3]string{{"a", "b"}, {"b", "a"}, {"b", "c"}}), Stats{3, 3, 0.5, 1, 3, 2, true}},
+		{"петля добавляет места петель", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"a", "a"}}), Stats{2, 2, 2.0 / 3, 1, 2, 1.5, true}},
+		{"петли запрещены", forbidden, Stats{3, 2, 2.0 / 3, 1, 2, 4.0 / 3, true}},
+		{"параллельные ребра считаются один раз", multigraph, Stats{2, 1, 1, 1, 1, 1, true}},
+		{"несвязный граф", mustGraph(t, false, false, [][3]string{{"a", "b"}, {"c", "d"}}), Stats{4, 2, 1.0 / 3, 1, 1, 1, false}},
+		{"одна вершина", single, Stats{1, 0, 0, 0, 0, 0, true}},
+		{"пустой граф", NewEmptyGraph(), Stats{0, 0, 0, 0, 0, 0, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.g.Stats()
+			if math.Abs(got.Density-tt.want.Density) > 1e-9 || math.Abs(got.AvgDegree-tt.want.AvgDegree) > 1e-9 {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+			got.Density, got.AvgDegree = tt.want.Density, tt.want.AvgDegree
+			if got != tt.want {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+			if d := tt.g.Density(); math.Abs(d-tt.want.Density) > 1e-9 {
+				t.Errorf("Density() = %v, want %v", d, tt.want.Density)
+			}
+		})
+	}
+}
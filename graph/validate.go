@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+
+Проверка согласованности внутреннего представления графа:
+- Validate - список нарушений инвариантов
+
+*/
+
+// Validate - проверяет согласованность хранимых данных и возвращает список обнаруженных нарушений
+// (пустой, если граф корректен), каждое нарушение описывает конкретную связь или вершину. Проверяется, что:
+// - связи не ссылаются на вершины, отсутствующие в графе;
+// - ребро неориентированного графа хранится в обоих списках смежности с одинаковым весом
+// (ребро, записанное только в одном направлении, например вызовом AddDirectedEdge, считается нарушением);
+// - все связи невзвешенного графа хранят вес -1, а веса связей взвешенного графа неотрицательны;
+// - в мультиграфе в edges хранится минимальный из весов параллельных связей;
+// - сохраненные полустепени захода совпадают с фактическими;
+// - индекс значений содержит ровно вершины графа.
+func (g *Graph) Validate() []error {
+	errs := []error{}
+	inDegree := make(map[*Node]int, len(g.edges))
+	for _, node := range g.sortedNodes() {
+		for _, next := range g.sortedTargets(node) {
+			w := g.edges[node][next]
+			if _, ok := g.edges[next]; !ok {
+				errs = append(errs, fmt.Errorf("Связь %s - %s ссылается на вершину, отсутствующую в графе", node.toString(), next.toString()))
+				continue
+			}
+			inDegree[next]++
+			if !g.is_oriented {
+				if back, ok := g.edges[next][node]; !ok {
+					errs = append(errs, fmt.Errorf("Ребро %s - %s хранится только в одном направлении", node.toString(), next.toString()))
+				} else if back != w && node.toString() < next.toString() {
+					errs = append(errs, fmt.Errorf("Ребро %s - %s имеет разные веса в двух направлениях: %d и %d", node.toString(), next.toString(), w, back))
+				}
+			}
+			if !g.is_suspended && w != -1 {
+				errs = append(errs, fmt.Errorf("Связь %s - %s невзвешенного графа хранит вес %d вместо -1", node.toString(), next.toString(), w))
+			}
+			if g.is_suspended && w < 0 && (g.is_oriented || node.toString() <= next.toString()) {
+				errs = append(errs, fmt.Errorf("Связь %s - %s взвешенного графа имеет отрицательный вес %d", node.toString(), next.toString(), w))
+			}
+			if g.is_multigraph {
+				weights := g.parallel[node][next]
+				min := 0
+				for i, pw := range weights {
+					if i == 0 || pw < min {
+						min = pw
+					}
+				}
+				if len(weights) == 0 || min != w {
+					errs = append(errs, fmt.Errorf("Связь %s - %s не согласована с параллельными связями мультиграфа", node.toString(), next.toString()))
+				}
+			}
+		}
+	}
+	for _, node := range g.sortedNodes() {
+		if g.in_degree[node] != inDegree[node] {
+			errs = append(errs, fmt.Errorf("Сохраненная полустепень захода вершины %s равна %d, фактическая - %d", node.toString(), g.in_degree[node], inDegree[node]))
+		}
+	}
+	for _, node := range g.sortedNodes() {
+		if g.index[node.toString()] != node {
+			errs = append(errs, fmt.Errorf("Вершина %s отсутствует в индексе значений", node.toString()))
+		}
+	}
+	if len(g.index) != len(g.edges) {
+		errs = append(errs, fmt.Errorf("Индекс значений содержит %d вершин, в графе %d", len(g.index), len(g.edges)))
+	}
+	return errs
+}
+
+// sortedTargets - возвращает концы всех дуг, исходящих из вершины (включая петлю), отсортированные по значению
+func (g *Graph) sortedTargets(node *Node) []*Node {
+	result := make([]*Node, 0, len(g.edges[node]))
+	for next := range g.edges[node] {
+		result = append(result, next)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].toString() < result[j].toString()
+	})
+	return result
+}
+
+// sortedNodes - возвращает все вершины графа, отсортированные по значению
+func (g *Graph) sortedNodes() []*Node {
+	result := make([]*Node, 0, len(g.edges))
+	for node := range g.edges {
+		result = append(result, node)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].toString() < result[j].toString()
+	})
+	return result
+}
@@ -0,0 +1,31 @@
+package graph
+
+import "errors"
+
+/*
+
+Анализ весов взвешенного графа:
+- WeightBuckets - распределение дуг / ребер по диапазонам весов
+
+*/
+
+// WeightBuckets - группирует дуги / ребра по диапазонам весов [k*bucketSize, (k+1)*bucketSize)
+// и возвращает словарь: начало диапазона -> количество связей в нем.
+// Ребро неориентированного графа считается один раз
+func (g *Graph) WeightBuckets(bucketSize int) (map[int]int, error) {
+	if bucketSize <= 0 {
+		return nil, errors.New("Размер диапазона должен быть положительным")
+	}
+	if !g.is_suspended {
+		return nil, errors.New("Граф должен быть взвешенным")
+	}
+	result := make(map[int]int)
+	for _, e := range g.edgeList() {
+		bucket := e.Weight / bucketSize
+		if e.Weight < 0 && e.Weight%bucketSize != 0 {
+			bucket-- // округление вниз для отрицательных весов
+		}
+		result[bucket*bucketSize]++
+	}
+	return result, nil
+}
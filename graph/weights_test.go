@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWeightBuckets(t *testing.T) {
+	tests := []struct {
+		name       string
+		oriented   bool
+		weighted   bool
+		edges      [][3]string
+		bucketSize int
+		want       map[int]int
+		wantErr    bool
+	}{
+		{"неориентированный граф", false, true,
+			[][3]string{{"a", "b", "3"}, {"b", "c", "9"}, {"c", "d", "10"}, {"d", "a", "25"}}, 10,
+			map[int]int{0: 2, 10: 1, 20: 1}, false},
+		{"встречные дуги орграфа", true, true, [][3]string{{"a", "b", "5"}, {"b", "a", "5"}}, 10, map[int]int{0: 2}, false},
+		{"отрицательные веса", true, true, [][3]string{{"a", "b", "-1"}, {"b", "c", "-10"}, {"c", "a", "-11"}}, 10,
+			map[int]int{-10: 2, -20: 1}, false},
+		{"граф без связей", true, true, nil, 5, map[int]int{}, false},
+		{"нулевой размер диапазона", true, true, [][3]string{{"a", "b", "1"}}, 0, nil, true},
+		{"невзвешенный граф", true, false, [][3]string{{"a", "b"}}, 10, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustGraph(t, tt.oriented, tt.weighted, tt.edges).WeightBuckets(tt.bucketSize)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WeightBuckets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WeightBuckets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunScript(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.txt")
+	if err := os.WriteFile(input, []byte("oriented\nsuspended\ns a 4\na t 3\ns t 2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "output.txt")
+
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{"построение графа вручную", "1\n5\na\n5\nb\n5\na\n6\na\nb\n5\n10\n0\n",
+			[]string{"Вершина a уже существует!", "- Ориентированный", "\t b : 5", "Выполнение программы остановлено!"}},
+		{"граф не задан", "7\n0\n", []string{"Граф не задан! Задайте граф и повторите попытку."}},
+		{"некорректное действие", "42\nx\n0\n", []string{"Неккоректная операция, введите число от 0 до 23"}},
+		{"загрузка из файла и поток", "2\n" + input + "\n23\ns\nt\n", []string{"Максимальный поток: 5"}},
+		{"некорректный файл не завершает работу", "2\n" + filepath.Join(dir, "missing.txt") + "\n1\n10\n0\n",
+			[]string{"Произошла ошибка", "- Взвешенный", "Выполнение программы остановлено!"}},
+		{"исток совпадает со стоком", "2\n" + input + "\n23\ns\ns\n0\n", []string{"Исток и сток должны различаться"}},
+		{"отрицательное расстояние", "1\n5\na\n6\na\na\n-3\n0\n", []string{"Некорректное значение расстояния"}},
+		{"сохранение в файл", "2\n" + input + "\n7\na\n9\n" + output + "\n0\n", []string{"Выполнение программы остановлено!"}},
+		{"полный граф", "4\n3\nx\ny\nz\n11\nx\n0\n", []string{"Степень полузахода вершины x равна: 2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := RunScript(strings.NewReader(tt.script), &out); err != nil {
+				t.Fatalf("RunScript() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("вывод не содержит %q:\n%s", want, out.String())
+				}
+			}
+		})
+	}
+
+	// Сохраненный без вершины a граф содержит одну дугу
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got, want := string(data), "oriented\nsuspended\ns t 2\n"; got != want {
+		t.Errorf("сохраненный файл = %q, want %q", got, want)
+	}
+}